@@ -0,0 +1,9 @@
+data "freeipa_automount_location" "datacenter1" {
+  name = "datacenter1"
+}
+
+output "automount_maps" {
+  value = {
+    for m in data.freeipa_automount_location.datacenter1.maps : m.name => [for k in m.keys : "${k.key} ${k.info}"]
+  }
+}
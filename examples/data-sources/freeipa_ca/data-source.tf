@@ -0,0 +1,7 @@
+data "freeipa_ca" "vpn" {
+  name = "vpn"
+}
+
+output "vpn_ca_chain" {
+  value = join("", data.freeipa_ca.vpn.certificate_chain)
+}
@@ -0,0 +1,13 @@
+data "freeipa_hbac_test" "ssh_access" {
+  user       = "user1"
+  targethost = "host1.example.lan"
+  service    = "sshd"
+  rules      = ["allow_ssh_admins"]
+}
+
+check "ssh_access" {
+  assert {
+    condition     = data.freeipa_hbac_test.ssh_access.access_granted
+    error_message = "user1 cannot log in to host1.example.lan through sshd"
+  }
+}
@@ -0,0 +1,7 @@
+data "freeipa_idranges" "local" {
+  range_type = "ipa-local"
+}
+
+output "local_ranges" {
+  value = { for r in data.freeipa_idranges.local.ranges : r.name => "${r.base_id}-${r.base_id + r.size - 1}" }
+}
@@ -0,0 +1,3 @@
+data "freeipa_password_policy" "global" {
+  name = "global_policy"
+}
@@ -0,0 +1,3 @@
+data "freeipa_permission" "read-users" {
+  name = "System: Read Users"
+}
@@ -0,0 +1,3 @@
+data "freeipa_privilege" "helpdesk" {
+  name = "Helpdesk"
+}
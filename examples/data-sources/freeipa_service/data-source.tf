@@ -0,0 +1,3 @@
+data "freeipa_service" "http-web" {
+  name = "HTTP/web.example.test"
+}
\ No newline at end of file
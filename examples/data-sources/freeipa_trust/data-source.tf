@@ -0,0 +1,3 @@
+data "freeipa_trust" "corp" {
+  realm = "corp.example.com"
+}
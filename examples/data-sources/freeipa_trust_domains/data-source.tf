@@ -0,0 +1,7 @@
+data "freeipa_trust_domains" "corp" {
+  realm = "corp.example.com"
+}
+
+output "corp_domains" {
+  value = [for d in data.freeipa_trust_domains.corp.domains : d.name]
+}
@@ -0,0 +1,18 @@
+variable "vault_password" {
+  type      = string
+  sensitive = true
+}
+
+ephemeral "freeipa_vault_secret" "team-secret" {
+  name     = "team-secret"
+  shared   = true
+  password = var.vault_password
+}
+
+# The secret can be passed to write-only attributes of other resources
+resource "freeipa_radius_proxy" "radius-1" {
+  name              = "radius-1"
+  servers           = ["radius-1.example.lan:1812"]
+  secret_wo         = ephemeral.freeipa_vault_secret.team-secret.data
+  secret_wo_version = 1
+}
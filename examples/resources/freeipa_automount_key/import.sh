@@ -0,0 +1,14 @@
+# The import id uses the format: <location>/<map_name>/<key>
+# Note: slash characters in the location, map or key must be percent-encoded (%2F).
+
+import {
+  to = freeipa_automount_key.share
+  id = "datacenter1/auto.shares/%2Fsrv%2Fshare"
+}
+
+resource "freeipa_automount_key" "share" {
+  location = "datacenter1"
+  map      = "auto.shares"
+  key      = "/srv/share"
+  info     = "-fstype=nfs4,ro nfs.example.lan:/exports/share"
+}
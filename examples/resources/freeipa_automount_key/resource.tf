@@ -0,0 +1,6 @@
+resource "freeipa_automount_key" "home" {
+  location = freeipa_automount_location.datacenter1.name
+  map      = freeipa_automount_map.home.name
+  key      = "*"
+  info     = "-fstype=nfs4,rw nfs.example.lan:/exports/home/&"
+}
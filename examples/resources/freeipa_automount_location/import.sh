@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the automount location.
+
+import {
+  to = freeipa_automount_location.datacenter1
+  id = "datacenter1"
+}
+
+resource "freeipa_automount_location" "datacenter1" {
+  name = "datacenter1"
+}
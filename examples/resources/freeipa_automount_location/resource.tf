@@ -0,0 +1,3 @@
+resource "freeipa_automount_location" "datacenter1" {
+  name = "datacenter1"
+}
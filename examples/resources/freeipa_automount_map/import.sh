@@ -0,0 +1,14 @@
+# The import id uses the format: <location>/<map_name>
+# Note: slash characters in the names must be percent-encoded (%2F).
+# The mount point is only imported when the map is referenced from auto.master.
+
+import {
+  to = freeipa_automount_map.home
+  id = "datacenter1/auto.home"
+}
+
+resource "freeipa_automount_map" "home" {
+  location    = "datacenter1"
+  name        = "auto.home"
+  mount_point = "/home"
+}
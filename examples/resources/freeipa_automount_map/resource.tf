@@ -0,0 +1,14 @@
+# Indirect map mounted on /home through auto.master
+resource "freeipa_automount_map" "home" {
+  location    = freeipa_automount_location.datacenter1.name
+  name        = "auto.home"
+  description = "Home directories"
+  mount_point = "/home"
+}
+
+# Direct map
+resource "freeipa_automount_map" "shares" {
+  location    = freeipa_automount_location.datacenter1.name
+  name        = "auto.shares"
+  mount_point = "/-"
+}
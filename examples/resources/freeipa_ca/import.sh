@@ -0,0 +1,11 @@
+# The import id must be exactly the same as the name of the CA.
+
+import {
+  to = freeipa_ca.vpn
+  id = "vpn"
+}
+
+resource "freeipa_ca" "vpn" {
+  name       = "vpn"
+  subject_dn = "CN=VPN CA,O=EXAMPLE.LAN"
+}
@@ -0,0 +1,17 @@
+resource "freeipa_ca" "vpn" {
+  name        = "vpn"
+  subject_dn  = "CN=VPN CA,O=EXAMPLE.LAN"
+  description = "Certificate authority for VPN clients"
+}
+
+resource "freeipa_caacl_ca_membership" "vpn-ca" {
+  name       = "vpn-users"
+  cas        = [freeipa_ca.vpn.name]
+  identifier = "vpn-ca"
+}
+
+resource "freeipa_certificate" "vpn-user-1" {
+  principal = "user-1"
+  csr       = file("${path.module}/user-1.csr")
+  ca        = freeipa_ca.vpn.name
+}
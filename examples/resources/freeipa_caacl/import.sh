@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the CA ACL.
+
+import {
+  to = freeipa_caacl.webservers
+  id = "webservers"
+}
+
+resource "freeipa_caacl" "webservers" {
+  name = "webservers"
+}
@@ -0,0 +1,7 @@
+resource "freeipa_caacl" "webservers" {
+  name            = "webservers"
+  description     = "Allow web servers to request certificates"
+  enabled         = true
+  servicecategory = "all"
+  cacategory      = "all"
+}
@@ -0,0 +1,13 @@
+# The import id uses the format: <caacl_name>/mc/<identifier>
+# Note: slash characters in the CA ACL name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_caacl_ca_membership.webservers-ca
+  id = "webservers/mc/webservers-ca"
+}
+
+resource "freeipa_caacl_ca_membership" "webservers-ca" {
+  name       = "webservers"
+  cas        = ["ipa"]
+  identifier = "webservers-ca"
+}
@@ -0,0 +1,10 @@
+resource "freeipa_caacl" "webservers" {
+  name        = "webservers"
+  description = "Allow web servers to request certificates"
+}
+
+resource "freeipa_caacl_ca_membership" "webservers-ca" {
+  name       = freeipa_caacl.webservers.name
+  cas        = ["ipa"]
+  identifier = "webservers-ca"
+}
@@ -0,0 +1,14 @@
+# The import id uses the format: <caacl_name>/mh/<identifier>
+# Note: slash characters in the CA ACL name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_caacl_host_membership.webservers-host
+  id = "webservers/mh/webservers-host"
+}
+
+resource "freeipa_caacl_host_membership" "webservers-host" {
+  name       = "webservers"
+  hosts      = ["web01.example.lan"]
+  hostgroups = ["webservers"]
+  identifier = "webservers-host"
+}
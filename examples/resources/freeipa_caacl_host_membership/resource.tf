@@ -0,0 +1,11 @@
+resource "freeipa_caacl" "webservers" {
+  name        = "webservers"
+  description = "Allow web servers to request certificates"
+}
+
+resource "freeipa_caacl_host_membership" "webservers-host" {
+  name       = freeipa_caacl.webservers.name
+  hosts      = ["web01.example.lan"]
+  hostgroups = ["webservers"]
+  identifier = "webservers-host"
+}
@@ -0,0 +1,13 @@
+# The import id uses the format: <caacl_name>/mp/<identifier>
+# Note: slash characters in the CA ACL name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_caacl_profile_membership.webservers-profile
+  id = "webservers/mp/webservers-profile"
+}
+
+resource "freeipa_caacl_profile_membership" "webservers-profile" {
+  name       = "webservers"
+  profiles   = ["webServerCert"]
+  identifier = "webservers-profile"
+}
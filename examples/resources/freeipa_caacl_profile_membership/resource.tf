@@ -0,0 +1,10 @@
+resource "freeipa_caacl" "webservers" {
+  name        = "webservers"
+  description = "Allow web servers to request certificates"
+}
+
+resource "freeipa_caacl_profile_membership" "webservers-profile" {
+  name       = freeipa_caacl.webservers.name
+  profiles   = ["webServerCert"]
+  identifier = "webservers-profile"
+}
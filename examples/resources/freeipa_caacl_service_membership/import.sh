@@ -0,0 +1,13 @@
+# The import id uses the format: <caacl_name>/ms/<identifier>
+# Note: slash characters in the CA ACL name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_caacl_service_membership.webservers-service
+  id = "webservers/ms/webservers-service"
+}
+
+resource "freeipa_caacl_service_membership" "webservers-service" {
+  name       = "webservers"
+  services   = ["HTTP/web01.example.lan"]
+  identifier = "webservers-service"
+}
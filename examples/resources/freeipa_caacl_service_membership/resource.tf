@@ -0,0 +1,10 @@
+resource "freeipa_caacl" "webservers" {
+  name        = "webservers"
+  description = "Allow web servers to request certificates"
+}
+
+resource "freeipa_caacl_service_membership" "webservers-service" {
+  name       = freeipa_caacl.webservers.name
+  services   = ["HTTP/web01.example.lan"]
+  identifier = "webservers-service"
+}
@@ -0,0 +1,14 @@
+# The import id uses the format: <caacl_name>/mu/<identifier>
+# Note: slash characters in the CA ACL name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_caacl_user_membership.webservers-user
+  id = "webservers/mu/webservers-user"
+}
+
+resource "freeipa_caacl_user_membership" "webservers-user" {
+  name       = "webservers"
+  users      = ["user-1"]
+  groups     = ["webadmins"]
+  identifier = "webservers-user"
+}
@@ -0,0 +1,11 @@
+resource "freeipa_caacl" "webservers" {
+  name        = "webservers"
+  description = "Allow web servers to request certificates"
+}
+
+resource "freeipa_caacl_user_membership" "webservers-user" {
+  name       = freeipa_caacl.webservers.name
+  users      = ["user-1"]
+  groups     = ["webadmins"]
+  identifier = "webservers-user"
+}
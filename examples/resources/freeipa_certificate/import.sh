@@ -0,0 +1,14 @@
+# The import id uses the format: <ca_name>/<serial_number>, the serial number in decimal or in hexadecimal prefixed with 0x.
+# The principal is read from the owner of the certificate. The certificate signing request cannot be read back
+# from the server, setting it on an imported certificate does not replace the certificate.
+# The revocation reason and the renewal window are imported with their default values.
+
+import {
+  to = freeipa_certificate.www
+  id = "ipa/0x2a"
+}
+
+resource "freeipa_certificate" "www" {
+  principal = "HTTP/www.example.lan"
+  csr       = file("www.example.lan.csr")
+}
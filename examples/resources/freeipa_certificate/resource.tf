@@ -0,0 +1,21 @@
+resource "tls_private_key" "www" {
+  algorithm   = "ECDSA"
+  ecdsa_curve = "P256"
+}
+
+resource "tls_cert_request" "www" {
+  private_key_pem = tls_private_key.www.private_key_pem
+  dns_names       = ["www.example.lan"]
+
+  subject {
+    common_name = "www.example.lan"
+  }
+}
+
+resource "freeipa_certificate" "www" {
+  principal           = "HTTP/www.example.lan"
+  csr                 = tls_cert_request.www.cert_request_pem
+  profile             = "caIPAserviceCert"
+  revocation_reason   = 4
+  early_renewal_hours = 720
+}
@@ -0,0 +1,13 @@
+# The import id must be exactly the same as the profile ID.
+# The profile configuration cannot be read back from FreeIPA, `config` is set from the configuration on the next apply.
+
+import {
+  to = freeipa_certificate_profile.webserver
+  id = "webServerCert"
+}
+
+resource "freeipa_certificate_profile" "webserver" {
+  name        = "webServerCert"
+  description = "Web server certificates"
+  config      = file("${path.module}/webServerCert.cfg")
+}
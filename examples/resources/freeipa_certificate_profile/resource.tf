@@ -0,0 +1,6 @@
+resource "freeipa_certificate_profile" "webserver" {
+  name        = "webServerCert"
+  description = "Web server certificates"
+  store       = true
+  config      = file("${path.module}/webServerCert.cfg")
+}
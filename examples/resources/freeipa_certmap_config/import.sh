@@ -0,0 +1,10 @@
+# The import id must be `certmap_config`.
+
+import {
+  to = freeipa_certmap_config.config
+  id = "certmap_config"
+}
+
+resource "freeipa_certmap_config" "config" {
+  prompt_username = true
+}
@@ -0,0 +1,3 @@
+resource "freeipa_certmap_config" "config" {
+  prompt_username = true
+}
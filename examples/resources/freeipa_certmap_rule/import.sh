@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the certificate mapping rule.
+
+import {
+  to = freeipa_certmap_rule.smartcard
+  id = "smartcard"
+}
+
+resource "freeipa_certmap_rule" "smartcard" {
+  name = "smartcard"
+}
@@ -0,0 +1,8 @@
+resource "freeipa_certmap_rule" "smartcard" {
+  name        = "smartcard"
+  description = "Smart card login for administrators"
+  match_rule  = "<ISSUER>CN=Smart Card CA,O=EXAMPLE.LAN"
+  map_rule    = "(ipacertmapdata=X509:<I>{issuer_dn!nss_x500}<S>{subject_dn!nss_x500})"
+  domains     = ["example.lan"]
+  priority    = 10
+}
@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the HBAC service.
+
+import {
+  to = freeipa_hbac_service.cockpit
+  id = "cockpit"
+}
+
+resource "freeipa_hbac_service" "cockpit" {
+  name = "cockpit"
+}
@@ -0,0 +1,4 @@
+resource "freeipa_hbac_service" "cockpit" {
+  name        = "cockpit"
+  description = "Cockpit web console"
+}
@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the HBAC service group.
+
+import {
+  to = freeipa_hbac_servicegroup.remote-access
+  id = "remote-access"
+}
+
+resource "freeipa_hbac_servicegroup" "remote-access" {
+  name = "remote-access"
+}
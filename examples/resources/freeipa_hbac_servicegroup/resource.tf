@@ -0,0 +1,4 @@
+resource "freeipa_hbac_servicegroup" "remote-access" {
+  name        = "remote-access"
+  description = "Remote access services"
+}
@@ -0,0 +1,12 @@
+# The import id uses the format: <hbac_servicegroup_name>/mu/<identifier>
+
+import {
+  to = freeipa_hbac_servicegroup_membership.remote-access
+  id = "remote-access/mu/remote-access-services"
+}
+
+resource "freeipa_hbac_servicegroup_membership" "remote-access" {
+  name       = "remote-access"
+  services   = ["sshd", "cockpit"]
+  identifier = "remote-access-services"
+}
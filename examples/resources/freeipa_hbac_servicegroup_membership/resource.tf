@@ -0,0 +1,5 @@
+resource "freeipa_hbac_servicegroup_membership" "remote-access" {
+  name       = "remote-access"
+  services   = ["sshd", "cockpit"]
+  identifier = "remote-access-services"
+}
@@ -0,0 +1,13 @@
+# The import id uses the format: <host_name>/mu/<identifier> for lists of members,
+# or <host_name>/<type>/<member> for a single member, with the type u (user), g (group), h (host) or hg (hostgroup).
+
+import {
+  to = freeipa_host_create_keytab_membership.vip-create-keytab
+  id = "vip.example.test/mu/vip-create-keytab"
+}
+
+resource "freeipa_host_create_keytab_membership" "vip-create-keytab" {
+  name       = "vip.example.test"
+  hostgroups = ["cluster-nodes"]
+  identifier = "vip-create-keytab"
+}
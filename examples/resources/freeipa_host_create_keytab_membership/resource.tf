@@ -0,0 +1,17 @@
+resource "freeipa_host" "vip" {
+  name       = "vip.example.test"
+  ip_address = "192.168.1.10"
+  force      = true
+}
+
+resource "freeipa_host_create_keytab_membership" "vip-create-keytab" {
+  name       = freeipa_host.vip.name
+  hosts      = ["node-1.example.test", "node-2.example.test"]
+  hostgroups = ["cluster-nodes"]
+  identifier = "vip-create-keytab"
+}
+
+resource "freeipa_host_create_keytab_membership" "vip-create-keytab-admin" {
+  name = freeipa_host.vip.name
+  user = "admin"
+}
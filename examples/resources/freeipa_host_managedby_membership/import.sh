@@ -0,0 +1,14 @@
+# The import id uses the format: <host_name>/mu/<identifier> for a list of hosts,
+# or <host_name>/h/<host> for a single host.
+# The host implicitly manages itself and is not imported in the list of hosts.
+
+import {
+  to = freeipa_host_managedby_membership.vip-managers
+  id = "vip.example.test/mu/vip-managers"
+}
+
+resource "freeipa_host_managedby_membership" "vip-managers" {
+  name       = "vip.example.test"
+  hosts      = ["node-1.example.test", "node-2.example.test"]
+  identifier = "vip-managers"
+}
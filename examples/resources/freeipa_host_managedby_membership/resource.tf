@@ -0,0 +1,16 @@
+resource "freeipa_host" "vip" {
+  name       = "vip.example.test"
+  ip_address = "192.168.1.10"
+  force      = true
+}
+
+resource "freeipa_host_managedby_membership" "vip-managers" {
+  name       = freeipa_host.vip.name
+  hosts      = ["node-1.example.test", "node-2.example.test"]
+  identifier = "vip-managers"
+}
+
+resource "freeipa_host_managedby_membership" "vip-managedby-node-3" {
+  name = freeipa_host.vip.name
+  host = "node-3.example.test"
+}
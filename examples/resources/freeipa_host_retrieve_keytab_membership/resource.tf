@@ -0,0 +1,17 @@
+resource "freeipa_host" "vip" {
+  name       = "vip.example.test"
+  ip_address = "192.168.1.10"
+  force      = true
+}
+
+resource "freeipa_host_retrieve_keytab_membership" "vip-retrieve-keytab" {
+  name       = freeipa_host.vip.name
+  hosts      = ["node-1.example.test", "node-2.example.test"]
+  hostgroups = ["cluster-nodes"]
+  identifier = "vip-retrieve-keytab"
+}
+
+resource "freeipa_host_retrieve_keytab_membership" "vip-retrieve-keytab-admin" {
+  name = freeipa_host.vip.name
+  user = "admin"
+}
@@ -0,0 +1,12 @@
+# The import id uses the format: <idview_name>/<anchor>
+# Note: slash characters in the ID view name or anchor must be percent-encoded (%2F).
+
+import {
+  to = freeipa_idoverride_group.staff
+  id = "legacy-unix/staff"
+}
+
+resource "freeipa_idoverride_group" "staff" {
+  idview = "legacy-unix"
+  anchor = "staff"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_idoverride_group" "staff" {
+  idview     = freeipa_idview.legacy.name
+  anchor     = "staff"
+  name       = "users"
+  gid_number = 100
+}
@@ -0,0 +1,12 @@
+# The import id uses the format: <idview_name>/<anchor>
+# Note: slash characters in the ID view name or anchor must be percent-encoded (%2F).
+
+import {
+  to = freeipa_idoverride_user.jdoe
+  id = "legacy-unix/jdoe"
+}
+
+resource "freeipa_idoverride_user" "jdoe" {
+  idview = "legacy-unix"
+  anchor = "jdoe"
+}
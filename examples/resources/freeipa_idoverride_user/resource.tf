@@ -0,0 +1,10 @@
+resource "freeipa_idoverride_user" "jdoe" {
+  idview         = freeipa_idview.legacy.name
+  anchor         = "jdoe"
+  description    = "Historical NIS account"
+  login          = "john"
+  uid_number     = 1042
+  gid_number     = 100
+  login_shell    = "/bin/ksh"
+  home_directory = "/export/home/john"
+}
@@ -0,0 +1,16 @@
+# The import id must be exactly the same as the name of the identity provider.
+# The provider template is not stored by the server, the endpoints are imported instead.
+
+import {
+  to = freeipa_idp.custom
+  id = "custom"
+}
+
+resource "freeipa_idp" "custom" {
+  name         = "custom"
+  client_id    = "freeipa"
+  auth_uri     = "https://sso.example.lan/oauth2/authorize"
+  dev_auth_uri = "https://sso.example.lan/oauth2/device"
+  token_uri    = "https://sso.example.lan/oauth2/token"
+  userinfo_uri = "https://sso.example.lan/oauth2/userinfo"
+}
@@ -0,0 +1,41 @@
+variable "keycloak_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "freeipa_idp" "keycloak" {
+  name                     = "keycloak"
+  provider_template        = "keycloak"
+  organization             = "example"
+  base_url                 = "keycloak.example.lan:8443"
+  client_id                = "freeipa"
+  client_secret_wo         = var.keycloak_client_secret
+  client_secret_wo_version = 1
+  idp_user_id              = "email"
+}
+
+resource "freeipa_idp" "azure" {
+  name              = "azure"
+  provider_template = "microsoft"
+  organization      = "00000000-0000-0000-0000-000000000000"
+  client_id         = "11111111-1111-1111-1111-111111111111"
+}
+
+resource "freeipa_idp" "custom" {
+  name         = "custom"
+  client_id    = "freeipa"
+  auth_uri     = "https://sso.example.lan/oauth2/authorize"
+  dev_auth_uri = "https://sso.example.lan/oauth2/device"
+  token_uri    = "https://sso.example.lan/oauth2/token"
+  userinfo_uri = "https://sso.example.lan/oauth2/userinfo"
+  scope        = "openid email"
+}
+
+resource "freeipa_user" "user-1" {
+  name                  = "user-1"
+  first_name            = "User"
+  last_name             = "One"
+  auth_type             = ["idp"]
+  external_idp_config   = freeipa_idp.keycloak.name
+  external_idp_username = "user-1@example.lan"
+}
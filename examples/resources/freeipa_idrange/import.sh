@@ -0,0 +1,12 @@
+# The import id must be exactly the same as the name of the ID range.
+
+import {
+  to = freeipa_idrange.finance
+  id = "finance"
+}
+
+resource "freeipa_idrange" "finance" {
+  name    = "finance"
+  base_id = 1200000
+  size    = 100000
+}
@@ -0,0 +1,17 @@
+resource "freeipa_idrange" "finance" {
+  name               = "finance"
+  base_id            = 1200000
+  size               = 100000
+  rid_base           = 1200000
+  secondary_rid_base = 101200000
+}
+
+resource "freeipa_idrange" "ad-trust" {
+  name                = "AD.EXAMPLE.LAN_id_range"
+  base_id             = 1800000000
+  size                = 200000
+  rid_base            = 0
+  range_type          = "ipa-ad-trust"
+  trusted_domain_name = "ad.example.lan"
+  auto_private_groups = "hybrid"
+}
@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the ID view.
+
+import {
+  to = freeipa_idview.legacy
+  id = "legacy-unix"
+}
+
+resource "freeipa_idview" "legacy" {
+  name = "legacy-unix"
+}
@@ -0,0 +1,5 @@
+resource "freeipa_idview" "legacy" {
+  name                    = "legacy-unix"
+  description             = "Keep the historical NIS uid/gid on legacy servers"
+  domain_resolution_order = "ipa.example.lan:ad.example.lan"
+}
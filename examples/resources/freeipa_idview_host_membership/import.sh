@@ -0,0 +1,14 @@
+# The import id uses the format: <idview_name>/mu/<identifier>
+# Note: slash characters in the ID view name must be percent-encoded (%2F).
+# Only the hosts the view is applied to can be read back from the server.
+
+import {
+  to = freeipa_idview_host_membership.legacy-hosts
+  id = "legacy-unix/mu/legacy-hosts"
+}
+
+resource "freeipa_idview_host_membership" "legacy-hosts" {
+  name       = "legacy-unix"
+  hosts      = ["legacy01.example.lan"]
+  identifier = "legacy-hosts"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_idview_host_membership" "legacy-hosts" {
+  name       = freeipa_idview.legacy.name
+  hosts      = ["legacy01.example.lan"]
+  hostgroups = ["legacy-servers"]
+  identifier = "legacy-hosts"
+}
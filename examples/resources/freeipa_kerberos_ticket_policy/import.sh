@@ -0,0 +1,10 @@
+# The import id is "global" for the global ticket policy, or the name of the user.
+
+import {
+  to = freeipa_kerberos_ticket_policy.ci
+  id = "svc-ci"
+}
+
+resource "freeipa_kerberos_ticket_policy" "ci" {
+  user = "svc-ci"
+}
@@ -0,0 +1,14 @@
+# Global ticket policy of the realm
+resource "freeipa_kerberos_ticket_policy" "global" {
+  max_life      = 86400
+  max_renew     = 604800
+  otp_max_life  = 43200
+  otp_max_renew = 86400
+}
+
+# Ticket policy of a service account
+resource "freeipa_kerberos_ticket_policy" "ci" {
+  user      = "svc-ci"
+  max_life  = 3600
+  max_renew = 7200
+}
@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the netgroup.
+
+import {
+  to = freeipa_netgroup.nis-clients
+  id = "nis-clients"
+}
+
+resource "freeipa_netgroup" "nis-clients" {
+  name = "nis-clients"
+}
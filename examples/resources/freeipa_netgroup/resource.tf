@@ -0,0 +1,5 @@
+resource "freeipa_netgroup" "nis-clients" {
+  name          = "nis-clients"
+  description   = "Legacy NIS clients"
+  external_host = ["legacy-01.example.lan", "legacy-02.example.lan"]
+}
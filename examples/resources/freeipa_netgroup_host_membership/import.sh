@@ -0,0 +1,13 @@
+# The import id uses the format: <netgroup_name>/mnh/<identifier>
+# Note: slash characters in the netgroup name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_netgroup_host_membership.nis-hosts
+  id = "nis-clients/mnh/nis-hosts"
+}
+
+resource "freeipa_netgroup_host_membership" "nis-hosts" {
+  name       = "nis-clients"
+  hosts      = ["host01.example.lan"]
+  identifier = "nis-hosts"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_netgroup_host_membership" "nis-hosts" {
+  name       = "nis-clients"
+  hosts      = ["host01.example.lan"]
+  hostgroups = ["nis-servers"]
+  identifier = "nis-hosts"
+}
@@ -0,0 +1,13 @@
+# The import id uses the format: <netgroup_name>/mnn/<identifier>
+# Note: slash characters in the netgroup name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_netgroup_netgroup_membership.nested
+  id = "nis-clients/mnn/nested"
+}
+
+resource "freeipa_netgroup_netgroup_membership" "nested" {
+  name       = "nis-clients"
+  netgroups  = ["nis-clients-dc1", "nis-clients-dc2"]
+  identifier = "nested"
+}
@@ -0,0 +1,5 @@
+resource "freeipa_netgroup_netgroup_membership" "nested" {
+  name       = "nis-clients"
+  netgroups  = ["nis-clients-dc1", "nis-clients-dc2"]
+  identifier = "nested"
+}
@@ -0,0 +1,13 @@
+# The import id uses the format: <netgroup_name>/mnu/<identifier>
+# Note: slash characters in the netgroup name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_netgroup_user_membership.nis-users
+  id = "nis-clients/mnu/nis-users"
+}
+
+resource "freeipa_netgroup_user_membership" "nis-users" {
+  name       = "nis-clients"
+  users      = ["user01"]
+  identifier = "nis-users"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_netgroup_user_membership" "nis-users" {
+  name       = "nis-clients"
+  users      = ["user01"]
+  groups     = ["nis-operators"]
+  identifier = "nis-users"
+}
@@ -0,0 +1,11 @@
+# The import id must be exactly the same as the unique id of the token.
+# The provisioning uri and the secret are not available after an import.
+
+import {
+  to = freeipa_otp_token.admin-1
+  id = "9b4d4f6e-6c3a-4b7e-9f2a-1f0c2d3e4a5b"
+}
+
+resource "freeipa_otp_token" "admin-1" {
+  owner = "admin-1"
+}
@@ -0,0 +1,21 @@
+resource "freeipa_user" "admin-1" {
+  name       = "admin-1"
+  first_name = "Admin"
+  last_name  = "One"
+  auth_type  = ["otp"]
+}
+
+resource "freeipa_otp_token" "admin-1" {
+  owner       = freeipa_user.admin-1.name
+  description = "TOTP token of admin-1"
+  algorithm   = "sha256"
+  digits      = 6
+  interval    = 30
+  not_after   = "2030-01-01T00:00:00Z"
+  managed_by  = ["helpdesk-1"]
+}
+
+output "admin-1-otp-uri" {
+  value     = freeipa_otp_token.admin-1.uri
+  sensitive = true
+}
@@ -0,0 +1,11 @@
+# The import id must be exactly the same as the name of the group, or global_policy.
+
+import {
+  to = freeipa_password_policy.admins
+  id = "admins"
+}
+
+resource "freeipa_password_policy" "admins" {
+  name     = "admins"
+  priority = 10
+}
@@ -0,0 +1,17 @@
+resource "freeipa_password_policy" "admins" {
+  name             = "admins"
+  priority         = 10
+  min_length       = 16
+  min_classes      = 3
+  history_length   = 10
+  max_lifetime     = 90
+  max_failures     = 5
+  failure_interval = 60
+  lockout_duration = 600
+}
+
+# The global policy is only modified, it is never created nor deleted
+resource "freeipa_password_policy" "global" {
+  name       = "global_policy"
+  min_length = 12
+}
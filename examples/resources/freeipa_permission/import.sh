@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the permission.
+
+import {
+  to = freeipa_permission.helpdesk-read-phones
+  id = "Helpdesk - Read user phone numbers"
+}
+
+resource "freeipa_permission" "helpdesk-read-phones" {
+  name = "Helpdesk - Read user phone numbers"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_permission" "helpdesk-read-phones" {
+  name  = "Helpdesk - Read user phone numbers"
+  right = ["read", "search", "compare"]
+  type  = "user"
+  attrs = ["telephonenumber", "mobile"]
+}
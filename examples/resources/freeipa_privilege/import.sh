@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the privilege.
+
+import {
+  to = freeipa_privilege.helpdesk
+  id = "Helpdesk"
+}
+
+resource "freeipa_privilege" "helpdesk" {
+  name = "Helpdesk"
+}
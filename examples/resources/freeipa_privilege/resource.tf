@@ -0,0 +1,4 @@
+resource "freeipa_privilege" "helpdesk" {
+  name        = "Helpdesk"
+  description = "Helpdesk privileges"
+}
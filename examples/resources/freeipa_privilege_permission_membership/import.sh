@@ -0,0 +1,12 @@
+# The import id uses the format: <privilege_name>/mu/<identifier>
+
+import {
+  to = freeipa_privilege_permission_membership.helpdesk-permissions
+  id = "Helpdesk/mu/helpdesk-permissions"
+}
+
+resource "freeipa_privilege_permission_membership" "helpdesk-permissions" {
+  name        = "Helpdesk"
+  permissions = ["Helpdesk - Read user phone numbers", "System: Change User password"]
+  identifier  = "helpdesk-permissions"
+}
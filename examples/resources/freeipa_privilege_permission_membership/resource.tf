@@ -0,0 +1,5 @@
+resource "freeipa_privilege_permission_membership" "helpdesk-permissions" {
+  name        = "Helpdesk"
+  permissions = ["Helpdesk - Read user phone numbers", "System: Change User password"]
+  identifier  = "helpdesk-permissions"
+}
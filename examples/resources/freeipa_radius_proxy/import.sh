@@ -0,0 +1,13 @@
+# The import id must be exactly the same as the name of the RADIUS proxy.
+# The shared secret is never read from the server, `secret_wo` must still be set in the configuration.
+
+import {
+  to = freeipa_radius_proxy.radius-1
+  id = "radius-1"
+}
+
+resource "freeipa_radius_proxy" "radius-1" {
+  name      = "radius-1"
+  servers   = ["radius-1.example.lan:1812"]
+  secret_wo = var.radius_secret
+}
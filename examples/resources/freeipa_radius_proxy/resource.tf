@@ -0,0 +1,24 @@
+variable "radius_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "freeipa_radius_proxy" "radius-1" {
+  name              = "radius-1"
+  description       = "Corporate RADIUS servers"
+  servers           = ["radius-1.example.lan:1812", "radius-2.example.lan:1812"]
+  secret_wo         = var.radius_secret
+  secret_wo_version = 1
+  timeout           = 5
+  retries           = 3
+  user_attribute    = "mail"
+}
+
+resource "freeipa_user" "user-1" {
+  name                  = "user-1"
+  first_name            = "User"
+  last_name             = "One"
+  auth_type             = ["radius"]
+  radius_proxy_config   = freeipa_radius_proxy.radius-1.name
+  radius_proxy_username = "user-1@example.lan"
+}
@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the name of the role.
+
+import {
+  to = freeipa_role.helpdesk
+  id = "Helpdesk"
+}
+
+resource "freeipa_role" "helpdesk" {
+  name = "Helpdesk"
+}
@@ -0,0 +1,4 @@
+resource "freeipa_role" "helpdesk" {
+  name        = "Helpdesk"
+  description = "Helpdesk operators"
+}
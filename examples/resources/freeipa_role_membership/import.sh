@@ -0,0 +1,13 @@
+# The import id uses the format: <role_name>/mu/<identifier>
+
+import {
+  to = freeipa_role_membership.helpdesk-users
+  id = "Helpdesk/mu/helpdesk-users"
+}
+
+resource "freeipa_role_membership" "helpdesk-users" {
+  name       = "Helpdesk"
+  users      = ["jdoe"]
+  groups     = ["helpdesk-operators"]
+  identifier = "helpdesk-users"
+}
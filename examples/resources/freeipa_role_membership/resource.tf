@@ -0,0 +1,20 @@
+resource "freeipa_role_membership" "helpdesk-users" {
+  name       = "Helpdesk"
+  users      = ["jdoe"]
+  groups     = ["helpdesk-operators"]
+  identifier = "helpdesk-users"
+}
+
+resource "freeipa_role_membership" "helpdesk-ci" {
+  name       = "Helpdesk"
+  hosts      = ["ci-runner-1.example.test"]
+  hostgroups = ["ci-runners"]
+  services   = ["ci/ci-runner-1.example.test"]
+  identifier = "helpdesk-ci"
+}
+
+resource "freeipa_role_membership" "helpdesk-sysaccounts" {
+  name        = "Helpdesk"
+  sysaccounts = ["helpdesk-app"]
+  identifier  = "helpdesk-sysaccounts"
+}
@@ -0,0 +1,12 @@
+# The import id uses the format: <role_name>/mu/<identifier>
+
+import {
+  to = freeipa_role_privilege_membership.helpdesk-privileges
+  id = "Helpdesk/mu/helpdesk-privileges"
+}
+
+resource "freeipa_role_privilege_membership" "helpdesk-privileges" {
+  name       = "Helpdesk"
+  privileges = ["Helpdesk"]
+  identifier = "helpdesk-privileges"
+}
@@ -0,0 +1,5 @@
+resource "freeipa_role_privilege_membership" "helpdesk-privileges" {
+  name       = "Helpdesk"
+  privileges = ["Helpdesk"]
+  identifier = "helpdesk-privileges"
+}
@@ -0,0 +1,11 @@
+# The import id must be exactly the same as the name of the SELinux user map.
+
+import {
+  to = freeipa_selinux_usermap.admins
+  id = "confined-admins"
+}
+
+resource "freeipa_selinux_usermap" "admins" {
+  name         = "confined-admins"
+  selinux_user = "staff_u:s0-s0:c0.c1023"
+}
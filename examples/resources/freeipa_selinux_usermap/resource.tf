@@ -0,0 +1,12 @@
+resource "freeipa_selinux_usermap" "admins" {
+  name         = "confined-admins"
+  description  = "Map administrators to the staff_u SELinux user"
+  selinux_user = "staff_u:s0-s0:c0.c1023"
+  hostcategory = "all"
+}
+
+resource "freeipa_selinux_usermap" "from-hbac" {
+  name         = "webadmins"
+  selinux_user = "staff_u:s0-s0:c0.c1023"
+  hbac_rule    = "allow_webadmins"
+}
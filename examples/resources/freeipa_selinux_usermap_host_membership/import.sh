@@ -0,0 +1,14 @@
+# The import id uses the format: <selinux_usermap_name>/mh/<identifier>
+# Note: slash characters in the SELinux user map name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_selinux_usermap_host_membership.admins-hosts
+  id = "confined-admins/mh/admins-hosts"
+}
+
+resource "freeipa_selinux_usermap_host_membership" "admins-hosts" {
+  name       = "confined-admins"
+  hosts      = ["web01.example.lan"]
+  hostgroups = ["webservers"]
+  identifier = "admins-hosts"
+}
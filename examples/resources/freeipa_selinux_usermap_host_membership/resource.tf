@@ -0,0 +1,11 @@
+resource "freeipa_selinux_usermap" "admins" {
+  name         = "confined-admins"
+  selinux_user = "staff_u:s0-s0:c0.c1023"
+}
+
+resource "freeipa_selinux_usermap_host_membership" "admins-hosts" {
+  name       = freeipa_selinux_usermap.admins.name
+  hosts      = ["web01.example.lan"]
+  hostgroups = ["webservers"]
+  identifier = "admins-hosts"
+}
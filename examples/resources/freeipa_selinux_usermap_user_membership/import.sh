@@ -0,0 +1,14 @@
+# The import id uses the format: <selinux_usermap_name>/mu/<identifier>
+# Note: slash characters in the SELinux user map name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_selinux_usermap_user_membership.admins-users
+  id = "confined-admins/mu/admins-users"
+}
+
+resource "freeipa_selinux_usermap_user_membership" "admins-users" {
+  name       = "confined-admins"
+  users      = ["user-1"]
+  groups     = ["admins"]
+  identifier = "admins-users"
+}
@@ -0,0 +1,11 @@
+resource "freeipa_selinux_usermap" "admins" {
+  name         = "confined-admins"
+  selinux_user = "staff_u:s0-s0:c0.c1023"
+}
+
+resource "freeipa_selinux_usermap_user_membership" "admins-users" {
+  name       = freeipa_selinux_usermap.admins.name
+  users      = ["user-1"]
+  groups     = ["admins"]
+  identifier = "admins-users"
+}
@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the service principal name, without the realm.
+
+import {
+  to = freeipa_service.http-web
+  id = "HTTP/web.ipatest.lan"
+}
+
+resource "freeipa_service" "http-web" {
+  name = "HTTP/web.ipatest.lan"
+}
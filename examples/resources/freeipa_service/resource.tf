@@ -0,0 +1,12 @@
+resource "freeipa_service" "http-web" {
+  name                = "HTTP/web.example.test"
+  krb_auth_indicators = ["otp", "radius"]
+  pac_type            = ["MS-PAC"]
+}
+
+resource "freeipa_service" "nfs-storage" {
+  name            = "nfs/storage.example.test"
+  pac_type        = ["NONE"]
+  force           = true
+  skip_host_check = true
+}
@@ -0,0 +1,15 @@
+# The import id uses the format: <service_name>/mu/<identifier> for a list of hosts,
+# or <service_name>/h/<host> for a single host.
+# The host of the service implicitly manages it and is not imported in the list of hosts.
+# Slashes in the service name must be encoded as %2F.
+
+import {
+  to = freeipa_service_managedby_membership.http-managers
+  id = "HTTP%2Fweb.example.test/mu/http-managers"
+}
+
+resource "freeipa_service_managedby_membership" "http-managers" {
+  name       = "HTTP/web.example.test"
+  hosts      = ["lb-1.example.test", "lb-2.example.test"]
+  identifier = "http-managers"
+}
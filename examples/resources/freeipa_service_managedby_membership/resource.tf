@@ -0,0 +1,14 @@
+resource "freeipa_service" "http" {
+  name = "HTTP/web.example.test"
+}
+
+resource "freeipa_service_managedby_membership" "http-managers" {
+  name       = freeipa_service.http.name
+  hosts      = ["lb-1.example.test", "lb-2.example.test"]
+  identifier = "http-managers"
+}
+
+resource "freeipa_service_managedby_membership" "http-managedby-lb-3" {
+  name = freeipa_service.http.name
+  host = "lb-3.example.test"
+}
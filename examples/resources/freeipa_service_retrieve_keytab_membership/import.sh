@@ -0,0 +1,14 @@
+# The import id uses the format: <service_name>/mu/<identifier> for lists of members,
+# or <service_name>/<type>/<member> for a single member, with the type u (user), g (group), h (host) or hg (hostgroup).
+# Slashes in the service name must be encoded as %2F.
+
+import {
+  to = freeipa_service_retrieve_keytab_membership.http-retrieve-keytab
+  id = "HTTP%2Fweb.example.test/mu/http-retrieve-keytab"
+}
+
+resource "freeipa_service_retrieve_keytab_membership" "http-retrieve-keytab" {
+  name       = "HTTP/web.example.test"
+  hostgroups = ["webservers"]
+  identifier = "http-retrieve-keytab"
+}
@@ -0,0 +1,17 @@
+resource "freeipa_service" "http" {
+  name = "HTTP/web.example.test"
+}
+
+resource "freeipa_service_retrieve_keytab_membership" "http-retrieve-keytab" {
+  name       = freeipa_service.http.name
+  users      = ["admin"]
+  groups     = ["web-admins"]
+  hosts      = ["lb-1.example.test"]
+  hostgroups = ["webservers"]
+  identifier = "http-retrieve-keytab"
+}
+
+resource "freeipa_service_retrieve_keytab_membership" "http-retrieve-keytab-admin" {
+  name = freeipa_service.http.name
+  user = "admin"
+}
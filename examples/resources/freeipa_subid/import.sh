@@ -0,0 +1,10 @@
+# The import id must be exactly the same as the login of the owner of the subordinate IDs.
+
+import {
+  to = freeipa_subid.user-1
+  id = "user-1"
+}
+
+resource "freeipa_subid" "user-1" {
+  owner = "user-1"
+}
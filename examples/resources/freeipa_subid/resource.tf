@@ -0,0 +1,14 @@
+resource "freeipa_user" "user-1" {
+  name       = "user-1"
+  first_name = "User"
+  last_name  = "One"
+}
+
+resource "freeipa_subid" "user-1" {
+  owner       = freeipa_user.user-1.name
+  description = "Subordinate IDs for rootless podman"
+}
+
+output "user-1-subuid-range" {
+  value = "${freeipa_subid.user-1.subuid_base}:${freeipa_subid.user-1.subuid_length}"
+}
@@ -18,4 +18,10 @@ resource "freeipa_sudo_rule_host_membership" "hostgroups-3" {
   name       = "sudo-rule-test"
   hostgroups = ["test-hostgroup"]
   identifier = "hostgroups-3"
-}
\ No newline at end of file
+}
+
+resource "freeipa_sudo_rule_host_membership" "external-hosts-4" {
+  name           = "sudo-rule-test"
+  external_hosts = ["legacy.example.lan"]
+  identifier     = "external-hosts-4"
+}
@@ -0,0 +1,11 @@
+# The import id must be exactly the same as the name of the sudo rule.
+
+import {
+  to = freeipa_sudo_rule_options.sysadmins
+  id = "sysadmins"
+}
+
+resource "freeipa_sudo_rule_options" "sysadmins" {
+  name    = "sysadmins"
+  options = ["!requiretty", "env_keep+=SSH_AUTH_SOCK"]
+}
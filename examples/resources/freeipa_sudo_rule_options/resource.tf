@@ -0,0 +1,9 @@
+resource "freeipa_sudo_rule" "sysadmins" {
+  name = "sysadmins"
+}
+
+# Any other option of the sudo rule, for instance added from the web UI, is removed
+resource "freeipa_sudo_rule_options" "sysadmins" {
+  name    = freeipa_sudo_rule.sysadmins.name
+  options = ["!requiretty", "env_keep+=SSH_AUTH_SOCK"]
+}
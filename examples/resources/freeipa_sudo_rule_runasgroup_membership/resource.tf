@@ -7,4 +7,10 @@ resource "freeipa_sudo_rule_runasgroup_membership" "groups-0" {
   name        = "sudo-rule-test"
   runasgroups = ["group01", "group02"]
   identifier  = "groups-0"
-}
\ No newline at end of file
+}
+
+resource "freeipa_sudo_rule_runasgroup_membership" "external-groups-1" {
+  name                 = "sudo-rule-test"
+  external_runasgroups = ["dba"]
+  identifier           = "external-groups-1"
+}
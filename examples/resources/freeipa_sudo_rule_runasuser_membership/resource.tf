@@ -8,3 +8,9 @@ resource "freeipa_sudo_rule_runasuser_membership" "users-0" {
   runasusers = ["user01", "user02"]
   identifier = "users-0"
 }
+
+resource "freeipa_sudo_rule_runasuser_membership" "external-users-1" {
+  name                = "sudo-rule-test"
+  external_runasusers = ["postgres"]
+  identifier          = "external-users-1"
+}
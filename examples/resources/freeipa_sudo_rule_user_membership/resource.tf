@@ -19,3 +19,9 @@ resource "freeipa_sudo_rule_user_membership" "groups-3" {
   groups     = ["test-group-0"]
   identifier = "groups-3"
 }
+
+resource "freeipa_sudo_rule_user_membership" "external-users-4" {
+  name           = "sudo-rule-test"
+  external_users = ["postgres"]
+  identifier     = "external-users-4"
+}
@@ -0,0 +1,12 @@
+# The import id must be exactly the same as the name of the system account.
+# The password is never read from the server, `password_wo` must still be set in the configuration.
+
+import {
+  to = freeipa_sysaccount.app
+  id = "app"
+}
+
+resource "freeipa_sysaccount" "app" {
+  name        = "app"
+  password_wo = var.app_ldap_password
+}
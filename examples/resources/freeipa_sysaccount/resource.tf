@@ -0,0 +1,33 @@
+variable "app_ldap_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "freeipa_sysaccount" "app" {
+  name                = "app"
+  description         = "LDAP bind user of the application"
+  password_wo         = var.app_ldap_password
+  password_wo_version = 1
+}
+
+# Grant the privileges of a role to the system account
+resource "freeipa_role" "app-ldap" {
+  name        = "App LDAP reader"
+  description = "LDAP access of the application"
+}
+
+resource "freeipa_role_privilege_membership" "app-ldap" {
+  name       = freeipa_role.app-ldap.name
+  privileges = ["User Administrators"]
+  identifier = "app-ldap-privileges"
+}
+
+resource "freeipa_role_membership" "app-ldap" {
+  name        = freeipa_role.app-ldap.name
+  sysaccounts = [freeipa_sysaccount.app.name]
+  identifier  = "app-ldap-sysaccounts"
+}
+
+output "app-bind-dn" {
+  value = freeipa_sysaccount.app.bind_dn
+}
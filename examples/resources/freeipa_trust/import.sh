@@ -0,0 +1,12 @@
+# The import id must be exactly the same as the realm of the trust.
+# The credentials used to establish the trust cannot be imported.
+
+import {
+  to = freeipa_trust.corp
+  id = "corp.example.com"
+}
+
+resource "freeipa_trust" "corp" {
+  realm            = "corp.example.com"
+  shared_secret_wo = var.ad_trust_secret
+}
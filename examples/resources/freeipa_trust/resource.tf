@@ -0,0 +1,24 @@
+variable "ad_trust_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "freeipa_trust" "corp" {
+  realm                    = "corp.example.com"
+  direction                = "one-way"
+  shared_secret_wo         = var.ad_trust_secret
+  shared_secret_wo_version = 1
+  range_type               = "ipa-ad-trust"
+}
+
+resource "freeipa_group" "corp-admins-external" {
+  name     = "corp-admins-external"
+  external = true
+}
+
+resource "freeipa_user_group_membership" "corp-admins" {
+  name             = freeipa_group.corp-admins-external.name
+  external_members = ["domain admins@${freeipa_trust.corp.realm}"]
+  identifier       = "corp-admins"
+}
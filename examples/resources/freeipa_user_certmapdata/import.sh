@@ -0,0 +1,13 @@
+# The import id uses the format: <user>/<issuer>/<subject>
+# Note: slash characters in the issuer or subject must be percent-encoded (%2F).
+
+import {
+  to = freeipa_user_certmapdata.jdoe-smartcard
+  id = "jdoe/CN=Smart Card CA,O=EXAMPLE.LAN/CN=John Doe,O=EXAMPLE.LAN"
+}
+
+resource "freeipa_user_certmapdata" "jdoe-smartcard" {
+  user    = "jdoe"
+  issuer  = "CN=Smart Card CA,O=EXAMPLE.LAN"
+  subject = "CN=John Doe,O=EXAMPLE.LAN"
+}
@@ -0,0 +1,5 @@
+resource "freeipa_user_certmapdata" "jdoe-smartcard" {
+  user    = "jdoe"
+  issuer  = "CN=Smart Card CA,O=EXAMPLE.LAN"
+  subject = "CN=John Doe,O=EXAMPLE.LAN"
+}
@@ -0,0 +1,12 @@
+# The import id must use one of the formats <name>/shared, <name>/service/<principal>, <name>/user/<username> or <name>/user (vault of the provider user).
+# The slashes of the service principal must be replaced by %2F. The payload of the vault is never imported.
+
+import {
+  to = freeipa_vault.app-secret
+  id = "app-secret/service/HTTP%2Fapp.example.lan"
+}
+
+resource "freeipa_vault" "app-secret" {
+  name    = "app-secret"
+  service = "HTTP/app.example.lan"
+}
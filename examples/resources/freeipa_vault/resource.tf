@@ -0,0 +1,33 @@
+variable "app_secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "vault_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "freeipa_vault" "app-secret" {
+  name            = "app-secret"
+  description     = "Shared secret of the application"
+  service         = "HTTP/app.example.lan"
+  data_wo         = var.app_secret
+  data_wo_version = 1
+}
+
+resource "freeipa_vault" "team-secret" {
+  name            = "team-secret"
+  type            = "symmetric"
+  shared          = true
+  password_wo     = var.vault_password
+  data_wo         = var.app_secret
+  data_wo_version = 1
+}
+
+resource "freeipa_vault" "backup-key" {
+  name       = "backup-key"
+  type       = "asymmetric"
+  username   = "backup"
+  public_key = file("backup-vault.pub")
+}
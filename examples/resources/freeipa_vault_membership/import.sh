@@ -0,0 +1,14 @@
+# The import id must use the format <vault id>/vm/<identifier>, where <vault id> is the id of the freeipa_vault resource.
+
+import {
+  to = freeipa_vault_membership.team-secret
+  id = "team-secret/shared/vm/team-secret"
+}
+
+resource "freeipa_vault_membership" "team-secret" {
+  name          = "team-secret"
+  shared        = true
+  identifier    = "team-secret"
+  owner_groups  = ["admins"]
+  member_groups = ["developers"]
+}
@@ -0,0 +1,9 @@
+resource "freeipa_vault_membership" "team-secret" {
+  name            = freeipa_vault.team-secret.name
+  shared          = true
+  identifier      = "team-secret"
+  owner_groups    = ["admins"]
+  member_users    = ["jdoe"]
+  member_groups   = ["developers"]
+  member_services = ["HTTP/app.example.lan"]
+}
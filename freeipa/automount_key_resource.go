@@ -0,0 +1,293 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AutomountKeyResource{}
+var _ resource.ResourceWithImportState = &AutomountKeyResource{}
+
+func NewAutomountKeyResource() resource.Resource {
+	return &AutomountKeyResource{}
+}
+
+// AutomountKeyResource defines the resource implementation.
+type AutomountKeyResource struct {
+	client *ipa.Client
+}
+
+// AutomountKeyResourceModel describes the resource data model.
+type AutomountKeyResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Location    types.String `tfsdk:"location"`
+	Map         types.String `tfsdk:"map"`
+	Key         types.String `tfsdk:"key"`
+	Info        types.String `tfsdk:"info"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *AutomountKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automount_key"
+}
+
+func (r *AutomountKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA automount key resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Automount location of the map",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"map": schema.StringAttribute{
+				MarkdownDescription: "Automount map the key belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Automount key name (e.g. `*` or the mount point)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"info": schema.StringAttribute{
+				MarkdownDescription: "Mount information (e.g. `-fstype=nfs4,rw nfs.example.lan:/exports/home/&`)",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Automount key description",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *AutomountKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AutomountKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AutomountKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.AutomountkeyAddArgs{
+		Automountlocationcn:  data.Location.ValueString(),
+		Automountmapname:     data.Map.ValueString(),
+		Automountkey:         data.Key.ValueString(),
+		Automountinformation: data.Info.ValueString(),
+	}
+	optArgs := ipa.AutomountkeyAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	_, err := r.client.AutomountkeyAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa automount key: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", encodeSlash(data.Location.ValueString()), encodeSlash(data.Map.ValueString()), encodeSlash(data.Key.ValueString())))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AutomountKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, mapName, key, err := parseAutomountKeyID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_automount_key: %s", err))
+		return
+	}
+
+	args := ipa.AutomountkeyShowArgs{
+		Automountlocationcn: location,
+		Automountmapname:    mapName,
+		Automountkey:        key,
+	}
+
+	res, err := r.client.AutomountkeyShow(&args, &ipa.AutomountkeyShowOptionalArgs{})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Automount key not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa automount key: %s", err))
+			return
+		}
+	}
+
+	data.Info = types.StringValue(res.Result.Automountinformation)
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AutomountKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.AutomountkeyModArgs{
+		Automountlocationcn: data.Location.ValueString(),
+		Automountmapname:    data.Map.ValueString(),
+		Automountkey:        data.Key.ValueString(),
+	}
+	optArgs := ipa.AutomountkeyModOptionalArgs{
+		Automountinformation: state.Info.ValueStringPointer(),
+	}
+
+	var hasChange = false
+
+	if !data.Info.Equal(state.Info) {
+		optArgs.Newautomountinformation = data.Info.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Description.Equal(state.Description) {
+		if data.Description.IsNull() {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.AutomountkeyMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa automount key: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = state.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AutomountKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, mapName, key, err := parseAutomountKeyID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_automount_key: %s", err))
+		return
+	}
+
+	args := ipa.AutomountkeyDelArgs{
+		Automountlocationcn: location,
+		Automountmapname:    mapName,
+		Automountkey:        key,
+	}
+	_, err = r.client.AutomountkeyDel(&args, &ipa.AutomountkeyDelOptionalArgs{Automountinformation: data.Info.ValueStringPointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa automount key: %s", err))
+		return
+	}
+}
+
+func (r *AutomountKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	location, mapName, key, err := parseAutomountKeyID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location"), location)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("map"), mapName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+func parseAutomountKeyID(id string) (string, string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 3 {
+		return "", "", "", fmt.Errorf("unable to determine automount key ID %s", id)
+	}
+
+	return decodeSlash(idParts[0]), decodeSlash(idParts[1]), decodeSlash(idParts[2]), nil
+}
@@ -0,0 +1,337 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+const automountMasterMapName = "auto.master"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AutomountMapResource{}
+var _ resource.ResourceWithImportState = &AutomountMapResource{}
+
+func NewAutomountMapResource() resource.Resource {
+	return &AutomountMapResource{}
+}
+
+// AutomountMapResource defines the resource implementation.
+type AutomountMapResource struct {
+	client *ipa.Client
+}
+
+// AutomountMapResourceModel describes the resource data model.
+type AutomountMapResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Location    types.String `tfsdk:"location"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	MountPoint  types.String `tfsdk:"mount_point"`
+	ParentMap   types.String `tfsdk:"parent_map"`
+}
+
+func (r *AutomountMapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automount_map"
+}
+
+func (r *AutomountMapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA automount map resource.\nWhen `mount_point` is set, the map is created as an indirect map and a key pointing to it is added to the parent map. Use `/-` as mount point to create a direct map.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Automount location of the map",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the automount map",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Automount map description",
+				Optional:            true,
+			},
+			"mount_point": schema.StringAttribute{
+				MarkdownDescription: "Mount point of the map in the parent map. Use `/-` for a direct map.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_map": schema.StringAttribute{
+				MarkdownDescription: "Name of the parent map the mount point is added to. Defaults to `auto.master`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("mount_point")),
+				},
+			},
+		},
+	}
+}
+
+func (r *AutomountMapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AutomountMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AutomountMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MountPoint.IsNull() {
+		args := ipa.AutomountmapAddArgs{
+			Automountlocationcn: data.Location.ValueString(),
+			Automountmapname:    data.Name.ValueString(),
+		}
+		optArgs := ipa.AutomountmapAddOptionalArgs{}
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		_, err := r.client.AutomountmapAdd(&args, &optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa automount map: %s", err))
+			return
+		}
+		data.ParentMap = types.StringNull()
+	} else {
+		if data.ParentMap.IsUnknown() || data.ParentMap.IsNull() {
+			data.ParentMap = types.StringValue(automountMasterMapName)
+		}
+		args := ipa.AutomountmapAddIndirectArgs{
+			Automountlocationcn: data.Location.ValueString(),
+			Automountmapname:    data.Name.ValueString(),
+			Key:                 data.MountPoint.ValueString(),
+		}
+		optArgs := ipa.AutomountmapAddIndirectOptionalArgs{
+			Parentmap: data.ParentMap.ValueStringPointer(),
+		}
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		_, err := r.client.AutomountmapAddIndirect(&args, &optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa indirect automount map: %s", err))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", encodeSlash(data.Location.ValueString()), encodeSlash(data.Name.ValueString())))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AutomountMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, mapName, err := parseAutomountMapID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_automount_map: %s", err))
+		return
+	}
+
+	args := ipa.AutomountmapShowArgs{
+		Automountlocationcn: location,
+		Automountmapname:    mapName,
+	}
+
+	res, err := r.client.AutomountmapShow(&args, &ipa.AutomountmapShowOptionalArgs{})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Automount map not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa automount map: %s", err))
+			return
+		}
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountMapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AutomountMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Description.Equal(state.Description) {
+		args := ipa.AutomountmapModArgs{
+			Automountlocationcn: data.Location.ValueString(),
+			Automountmapname:    data.Name.ValueString(),
+		}
+		optArgs := ipa.AutomountmapModOptionalArgs{}
+		if data.Description.IsNull() {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		_, err := r.client.AutomountmapMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa automount map: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = state.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountMapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AutomountMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, mapName, err := parseAutomountMapID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_automount_map: %s", err))
+		return
+	}
+
+	// Deleting the map also removes the key referencing it in the parent map.
+	args := ipa.AutomountmapDelArgs{
+		Automountlocationcn: location,
+		Automountmapname:    []string{mapName},
+	}
+	_, err = r.client.AutomountmapDel(&args, &ipa.AutomountmapDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa automount map: %s", err))
+		return
+	}
+}
+
+func (r *AutomountMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	location, mapName, err := parseAutomountMapID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	res, err := r.client.AutomountmapShow(&ipa.AutomountmapShowArgs{Automountlocationcn: location, Automountmapname: mapName}, &ipa.AutomountmapShowOptionalArgs{})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Automount map not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa automount map: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location"), location)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), mapName)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), res.Result.Description)...)
+	}
+
+	// The mount point is only known from the key referencing the map. Only the master map is looked up.
+	keys, err := r.client.AutomountkeyFind("", &ipa.AutomountkeyFindArgs{Automountlocationcn: location, Automountmapname: automountMasterMapName}, &ipa.AutomountkeyFindOptionalArgs{})
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Import freeipa automount map: unable to list keys of %s: %s", automountMasterMapName, err))
+		return
+	}
+	for _, key := range keys.Result {
+		info := strings.Fields(key.Automountinformation)
+		if len(info) > 0 && info[len(info)-1] == mapName {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mount_point"), key.Automountkey)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_map"), automountMasterMapName)...)
+			return
+		}
+	}
+}
+
+func parseAutomountMapID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 {
+		return "", "", fmt.Errorf("unable to determine automount map ID %s", id)
+	}
+
+	return decodeSlash(idParts[0]), decodeSlash(idParts[1]), nil
+}
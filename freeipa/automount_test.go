@@ -0,0 +1,103 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAAutomount_simple(t *testing.T) {
+	testLocation := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-location\"",
+	}
+	testIndirectMap := map[string]string{
+		"index":       "0",
+		"location":    "freeipa_automount_location.automount-location-0.name",
+		"name":        "\"auto.testacc-home\"",
+		"description": "\"Home directories for acceptance tests\"",
+		"mount_point": "\"/home\"",
+	}
+	testDirectMap := map[string]string{
+		"index":       "1",
+		"location":    "freeipa_automount_location.automount-location-0.name",
+		"name":        "\"auto.testacc-direct\"",
+		"mount_point": "\"/-\"",
+	}
+	testKey := map[string]string{
+		"index":    "0",
+		"location": "freeipa_automount_location.automount-location-0.name",
+		"map":      "freeipa_automount_map.automount-map-0.name",
+		"key":      "\"*\"",
+		"info":     "\"-fstype=nfs4,rw nfs.testacc.lan:/exports/home/&\"",
+	}
+	testKeyModified := map[string]string{
+		"index":       "0",
+		"location":    "freeipa_automount_location.automount-location-0.name",
+		"map":         "freeipa_automount_map.automount-map-0.name",
+		"key":         "\"*\"",
+		"info":        "\"-fstype=nfs4,rw,soft nfs.testacc.lan:/exports/home/&\"",
+		"description": "\"Wildcard home key\"",
+	}
+	testDirectKey := map[string]string{
+		"index":    "1",
+		"location": "freeipa_automount_location.automount-location-0.name",
+		"map":      "freeipa_automount_map.automount-map-1.name",
+		"key":      "\"/srv/share\"",
+		"info":     "\"-fstype=nfs4,ro nfs.testacc.lan:/exports/share\"",
+	}
+	testLocationDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_automount_location.automount-location-0.name",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountMap_resource(testIndirectMap) + testAccFreeIPAAutomountMap_resource(testDirectMap) + testAccFreeIPAAutomountKey_resource(testDirectKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPAAutomountKey_resource(testKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_automount_location.automount-location-0", "name", "testacc-location"),
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-0", "id", "testacc-location/auto.testacc-home"),
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-0", "parent_map", "auto.master"),
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-1", "mount_point", "/-"),
+					resource.TestCheckResourceAttr("freeipa_automount_key.automount-key-0", "info", "-fstype=nfs4,rw nfs.testacc.lan:/exports/home/&"),
+					resource.TestCheckResourceAttr("freeipa_automount_key.automount-key-1", "id", "testacc-location/auto.testacc-direct/%2Fsrv%2Fshare"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAAutomountKey_resource(testKeyModified) + testAccFreeIPAAutomountLocation_datasource(testLocationDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_automount_key.automount-key-0", "info", "-fstype=nfs4,rw,soft nfs.testacc.lan:/exports/home/&"),
+					resource.TestCheckResourceAttr("freeipa_automount_key.automount-key-0", "description", "Wildcard home key"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.freeipa_automount_location.automount-location-0", "maps.*", map[string]string{
+						"name":        "auto.testacc-home",
+						"description": "Home directories for acceptance tests",
+						"keys.#":      "1",
+						"keys.0.key":  "*",
+						"keys.0.info": "-fstype=nfs4,rw,soft nfs.testacc.lan:/exports/home/&",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.freeipa_automount_location.automount-location-0", "maps.*", map[string]string{
+						"name": "auto.master",
+					}),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAAutomountKey_resource(testKeyModified) + testAccFreeIPAAutomountLocation_datasource(testLocationDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CaDataSource{}
+var _ datasource.DataSourceWithConfigure = &CaDataSource{}
+
+func NewCaDataSource() datasource.DataSource {
+	return &CaDataSource{}
+}
+
+// CaDataSource defines the data source implementation.
+type CaDataSource struct {
+	client *ipa.Client
+}
+
+// CaDataSourceModel describes the data source data model.
+type CaDataSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	CaId             types.String `tfsdk:"ca_id"`
+	SubjectDn        types.String `tfsdk:"subject_dn"`
+	IssuerDn         types.String `tfsdk:"issuer_dn"`
+	Certificate      types.String `tfsdk:"certificate"`
+	CertificateChain types.List   `tfsdk:"certificate_chain"`
+}
+
+func (r *CaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ca"
+}
+
+func (r *CaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA certificate authority data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CA (`ipa` for the main IPA CA)",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the purpose of the CA",
+				Computed:            true,
+			},
+			"ca_id": schema.StringAttribute{
+				MarkdownDescription: "Dogtag authority ID of the CA",
+				Computed:            true,
+			},
+			"subject_dn": schema.StringAttribute{
+				MarkdownDescription: "Subject distinguished name of the CA",
+				Computed:            true,
+			},
+			"issuer_dn": schema.StringAttribute{
+				MarkdownDescription: "Issuer distinguished name of the CA",
+				Computed:            true,
+			},
+			"certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate of the CA",
+				Computed:            true,
+			},
+			"certificate_chain": schema.ListAttribute{
+				MarkdownDescription: "PEM encoded certificate chain of the CA, starting with the CA certificate itself",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *CaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CaDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	chain := true
+	res, err := r.client.CaShow(&ipa.CaShowArgs{Cn: data.Name.ValueString()}, &ipa.CaShowOptionalArgs{All: &all, Chain: &chain})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa ca %s: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.Description = types.StringPointerValue(res.Result.Description)
+	data.CaId = types.StringValue(res.Result.Ipacaid)
+	data.SubjectDn = types.StringValue(res.Result.Ipacasubjectdn)
+	data.IssuerDn = types.StringPointerValue(res.Result.Ipacaissuerdn)
+	if res.Result.Certificate != nil {
+		cert, err := derToPem(*res.Result.Certificate)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error decoding certificate of freeipa ca %s: %s", data.Name.ValueString(), err))
+			return
+		}
+		data.Certificate = types.StringValue(cert)
+	}
+	certChain := []string{}
+	if res.Result.CertificateChain != nil {
+		for _, c := range *res.Result.CertificateChain {
+			cert, err := derToPem(c)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error decoding certificate chain of freeipa ca %s: %s", data.Name.ValueString(), err))
+				return
+			}
+			certChain = append(certChain, cert)
+		}
+	}
+	var diag diag.Diagnostics
+	data.CertificateChain, diag = types.ListValueFrom(ctx, types.StringType, certChain)
+	if diag.HasError() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+	}
+	data.Id = types.StringValue(res.Result.Cn)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// derToPem converts a base64 encoded DER certificate as returned by the api into PEM format.
+func derToPem(certificate string) (string, error) {
+	der, err := base64.StdEncoding.DecodeString(certificate)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
@@ -0,0 +1,308 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaResource{}
+var _ resource.ResourceWithImportState = &CaResource{}
+
+func NewCaResource() resource.Resource {
+	return &CaResource{}
+}
+
+// CaResource defines the resource implementation.
+type CaResource struct {
+	client *ipa.Client
+}
+
+// CaResourceModel describes the resource data model.
+type CaResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	SubjectDn   types.String `tfsdk:"subject_dn"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	CaId        types.String `tfsdk:"ca_id"`
+	IssuerDn    types.String `tfsdk:"issuer_dn"`
+}
+
+func (r *CaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ca"
+}
+
+func (r *CaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA lightweight sub-CA resource.\nThe sub-CA is signed by the IPA CA. Deleting the resource disables then deletes the sub-CA.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name for referencing the CA (used as `ca` in CA ACLs and certificate requests)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject_dn": schema.StringAttribute{
+				MarkdownDescription: "Subject distinguished name of the CA (ie: `CN=VPN CA,O=EXAMPLE.LAN`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the purpose of the CA",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the CA. A disabled CA cannot issue certificates. The status is not reported by FreeIPA and is only tracked in the state.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ca_id": schema.StringAttribute{
+				MarkdownDescription: "Dogtag authority ID of the CA",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issuer_dn": schema.StringAttribute{
+				MarkdownDescription: "Issuer distinguished name of the CA",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaAddOptionalArgs{}
+
+	args := ipa.CaAddArgs{
+		Cn:             data.Name.ValueString(),
+		Ipacasubjectdn: data.SubjectDn.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+
+	res, err := r.client.CaAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa ca: %s", err))
+		return
+	}
+	data.Id = data.Name
+	data.CaId = types.StringValue(res.Result.Ipacaid)
+	data.IssuerDn = types.StringPointerValue(res.Result.Ipacaissuerdn)
+
+	if !data.Enabled.ValueBool() {
+		_, err := r.client.CaDisable(&ipa.CaDisableArgs{Cn: data.Id.ValueString()}, &ipa.CaDisableOptionalArgs{})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disabling freeipa ca: %s", err))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CaShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.CaShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] CA not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa ca: %s", err))
+			return
+		}
+	}
+
+	data.Name = types.StringValue(res.Result.Cn)
+	data.SubjectDn = types.StringValue(res.Result.Ipacasubjectdn)
+	data.CaId = types.StringValue(res.Result.Ipacaid)
+	data.IssuerDn = types.StringPointerValue(res.Result.Ipacaissuerdn)
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CaModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.CaModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Enabled.Equal(state.Enabled) {
+		if !data.Enabled.ValueBool() {
+			_, err := r.client.CaDisable(&ipa.CaDisableArgs{Cn: data.Id.ValueString()}, &ipa.CaDisableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disabling freeipa ca: %s", err))
+			}
+		} else {
+			_, err := r.client.CaEnable(&ipa.CaEnableArgs{Cn: data.Id.ValueString()}, &ipa.CaEnableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error enabling freeipa ca: %s", err))
+			}
+		}
+	}
+
+	if hasChange {
+		_, err := r.client.CaMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa ca: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CaDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.CaDel(&args, &ipa.CaDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa ca: %s", err))
+		return
+	}
+}
+
+func (r *CaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	res, err := r.client.CaShow(&ipa.CaShowArgs{Cn: req.ID}, &ipa.CaShowOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa ca: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), true)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), *res.Result.Description)...)
+	}
+}
@@ -0,0 +1,81 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACa_full(t *testing.T) {
+	testCa := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-vpn-ca\"",
+		"subject_dn":  "\"CN=Testacc VPN CA,O=IPATEST.LAN\"",
+		"description": "\"VPN sub-CA for acceptance tests\"",
+	}
+	testCaModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-vpn-ca\"",
+		"subject_dn":  "\"CN=Testacc VPN CA,O=IPATEST.LAN\"",
+		"description": "\"VPN sub-CA for acceptance tests (modified)\"",
+		"enabled":     "false",
+	}
+	testCaDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_ca.ca-0.name",
+	}
+	testIpaCaDS := map[string]string{
+		"index": "1",
+		"name":  "\"ipa\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCa),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "name", "testacc-vpn-ca"),
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "subject_dn", "CN=Testacc VPN CA,O=IPATEST.LAN"),
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "description", "VPN sub-CA for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("freeipa_ca.ca-0", "ca_id"),
+					resource.TestCheckResourceAttrSet("freeipa_ca.ca-0", "issuer_dn"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCaModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "description", "VPN sub-CA for acceptance tests (modified)"),
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCaModified) + testAccFreeIPACa_datasource(testCaDS) + testAccFreeIPACa_datasource(testIpaCaDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_ca.ca-0", "name", "testacc-vpn-ca"),
+					resource.TestCheckResourceAttr("data.freeipa_ca.ca-0", "subject_dn", "CN=Testacc VPN CA,O=IPATEST.LAN"),
+					resource.TestCheckResourceAttrPair("data.freeipa_ca.ca-0", "ca_id", "freeipa_ca.ca-0", "ca_id"),
+					resource.TestCheckResourceAttr("data.freeipa_ca.ca-0", "certificate_chain.#", "2"),
+					resource.TestCheckResourceAttrSet("data.freeipa_ca.ca-0", "certificate"),
+					resource.TestCheckResourceAttr("data.freeipa_ca.ca-1", "certificate_chain.#", "1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCaModified) + testAccFreeIPACa_datasource(testCaDS) + testAccFreeIPACa_datasource(testIpaCaDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,394 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaaclResource{}
+var _ resource.ResourceWithImportState = &CaaclResource{}
+
+func NewCaaclResource() resource.Resource {
+	return &CaaclResource{}
+}
+
+// CaaclResource defines the resource implementation.
+type CaaclResource struct {
+	client *ipa.Client
+}
+
+// CaaclResourceModel describes the resource data model.
+type CaaclResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	UserCategory    types.String `tfsdk:"usercategory"`
+	HostCategory    types.String `tfsdk:"hostcategory"`
+	ServiceCategory types.String `tfsdk:"servicecategory"`
+	ProfileCategory types.String `tfsdk:"profilecategory"`
+	CaCategory      types.String `tfsdk:"cacategory"`
+}
+
+func (r *CaaclResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caacl"
+}
+
+func (r *CaaclResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *CaaclResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA CA ACL resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CA ACL",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "CA ACL description",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this CA ACL",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"usercategory": schema.StringAttribute{
+				MarkdownDescription: "User category the CA ACL is applied to (allowed value: all)",
+				Optional:            true,
+			},
+			"hostcategory": schema.StringAttribute{
+				MarkdownDescription: "Host category the CA ACL is applied to (allowed value: all)",
+				Optional:            true,
+			},
+			"servicecategory": schema.StringAttribute{
+				MarkdownDescription: "Service category the CA ACL is applied to (allowed value: all)",
+				Optional:            true,
+			},
+			"profilecategory": schema.StringAttribute{
+				MarkdownDescription: "Certificate profile category the CA ACL is applied to (allowed value: all)",
+				Optional:            true,
+			},
+			"cacategory": schema.StringAttribute{
+				MarkdownDescription: "Certificate authority category the CA ACL is applied to (allowed value: all)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *CaaclResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaaclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaaclResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaaclAddOptionalArgs{}
+
+	args := ipa.CaaclAddArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.Enabled.IsNull() {
+		v := data.Enabled.ValueBool()
+		optArgs.Ipaenabledflag = &v
+	}
+	if !data.UserCategory.IsNull() {
+		optArgs.Usercategory = data.UserCategory.ValueStringPointer()
+	}
+	if !data.HostCategory.IsNull() {
+		optArgs.Hostcategory = data.HostCategory.ValueStringPointer()
+	}
+	if !data.ServiceCategory.IsNull() {
+		optArgs.Servicecategory = data.ServiceCategory.ValueStringPointer()
+	}
+	if !data.ProfileCategory.IsNull() {
+		optArgs.Ipacertprofilecategory = data.ProfileCategory.ValueStringPointer()
+	}
+	if !data.CaCategory.IsNull() {
+		optArgs.Ipacacategory = data.CaCategory.ValueStringPointer()
+	}
+	_, err := r.client.CaaclAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa caacl: %s", err))
+		return
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaaclResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CaaclShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] CA ACL not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa caacl: %s", err))
+			return
+		}
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipaenabledflag != nil && !data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(*res.Result.Ipaenabledflag)
+	}
+	if res.Result.Usercategory != nil && !data.UserCategory.IsNull() {
+		data.UserCategory = types.StringValue(*res.Result.Usercategory)
+	}
+	if res.Result.Hostcategory != nil && !data.HostCategory.IsNull() {
+		data.HostCategory = types.StringValue(*res.Result.Hostcategory)
+	}
+	if res.Result.Servicecategory != nil && !data.ServiceCategory.IsNull() {
+		data.ServiceCategory = types.StringValue(*res.Result.Servicecategory)
+	}
+	if res.Result.Ipacertprofilecategory != nil && !data.ProfileCategory.IsNull() {
+		data.ProfileCategory = types.StringValue(*res.Result.Ipacertprofilecategory)
+	}
+	if res.Result.Ipacacategory != nil && !data.CaCategory.IsNull() {
+		data.CaCategory = types.StringValue(*res.Result.Ipacacategory)
+	}
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CaaclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CaaclResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CaaclModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.CaaclModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = data.Description.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Enabled.Equal(state.Enabled) {
+		if !data.Enabled.ValueBool() {
+			_, err := r.client.CaaclDisable(&ipa.CaaclDisableArgs{Cn: data.Id.ValueString()}, &ipa.CaaclDisableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disabling freeipa caacl: %s", err))
+			}
+		} else {
+			_, err := r.client.CaaclEnable(&ipa.CaaclEnableArgs{Cn: data.Id.ValueString()}, &ipa.CaaclEnableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error enabling freeipa caacl: %s", err))
+			}
+		}
+	}
+	if !data.UserCategory.Equal(state.UserCategory) {
+		if data.UserCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Usercategory = &v
+		} else {
+			optArgs.Usercategory = data.UserCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.HostCategory.Equal(state.HostCategory) {
+		if data.HostCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Hostcategory = &v
+		} else {
+			optArgs.Hostcategory = data.HostCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.ServiceCategory.Equal(state.ServiceCategory) {
+		if data.ServiceCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Servicecategory = &v
+		} else {
+			optArgs.Servicecategory = data.ServiceCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.ProfileCategory.Equal(state.ProfileCategory) {
+		if data.ProfileCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Ipacertprofilecategory = &v
+		} else {
+			optArgs.Ipacertprofilecategory = data.ProfileCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.CaCategory.Equal(state.CaCategory) {
+		if data.CaCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Ipacacategory = &v
+		} else {
+			optArgs.Ipacacategory = data.CaCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.CaaclMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa caacl: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaaclResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CaaclDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.CaaclDel(&args, &ipa.CaaclDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa caacl: %s", err))
+		return
+	}
+}
+
+func (r *CaaclResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CaaclShowArgs{
+		Cn: req.ID,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] CA ACL not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa caacl: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	if res.Result.Ipaenabledflag != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), res.Result.Ipaenabledflag)...)
+	}
+}
@@ -0,0 +1,278 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// testAccFreeIPACertificateProfileConfig returns a minimal Dogtag server certificate profile as a heredoc string.
+func testAccFreeIPACertificateProfileConfig(profileId string, validity int) string {
+	return fmt.Sprintf(`<<EOT
+profileId=%[1]s
+classId=caEnrollImpl
+desc=Terraform acceptance test profile
+visible=false
+enable=true
+enableBy=ipara
+auth.instance_id=raCertAuth
+name=Terraform acceptance test profile
+input.list=i1,i2
+input.i1.class_id=certReqInputImpl
+input.i2.class_id=submitterInfoInputImpl
+output.list=o1
+output.o1.class_id=certOutputImpl
+policyset.list=serverCertSet
+policyset.serverCertSet.list=1,2,3,4,5,6
+policyset.serverCertSet.1.constraint.class_id=subjectNameConstraintImpl
+policyset.serverCertSet.1.constraint.name=Subject Name Constraint
+policyset.serverCertSet.1.constraint.params.accept=true
+policyset.serverCertSet.1.constraint.params.pattern=CN=[^,]+,.+
+policyset.serverCertSet.1.default.class_id=subjectNameDefaultImpl
+policyset.serverCertSet.1.default.name=Subject Name Default
+policyset.serverCertSet.1.default.params.name=CN=$request.req_subject_name.cn$, O=IPA
+policyset.serverCertSet.2.constraint.class_id=validityConstraintImpl
+policyset.serverCertSet.2.constraint.name=Validity Constraint
+policyset.serverCertSet.2.constraint.params.notAfterCheck=false
+policyset.serverCertSet.2.constraint.params.notBeforeCheck=false
+policyset.serverCertSet.2.constraint.params.range=%[2]d
+policyset.serverCertSet.2.default.class_id=validityDefaultImpl
+policyset.serverCertSet.2.default.name=Validity Default
+policyset.serverCertSet.2.default.params.range=%[2]d
+policyset.serverCertSet.2.default.params.startTime=0
+policyset.serverCertSet.3.constraint.class_id=keyConstraintImpl
+policyset.serverCertSet.3.constraint.name=Key Constraint
+policyset.serverCertSet.3.constraint.params.keyParameters=nistp256,nistp384,nistp521,2048,3072,4096
+policyset.serverCertSet.3.constraint.params.keyType=-
+policyset.serverCertSet.3.default.class_id=userKeyDefaultImpl
+policyset.serverCertSet.3.default.name=Key Default
+policyset.serverCertSet.4.constraint.class_id=noConstraintImpl
+policyset.serverCertSet.4.constraint.name=No Constraint
+policyset.serverCertSet.4.default.class_id=authorityKeyIdentifierExtDefaultImpl
+policyset.serverCertSet.4.default.name=Authority Key Identifier Default
+policyset.serverCertSet.5.constraint.class_id=keyUsageExtConstraintImpl
+policyset.serverCertSet.5.constraint.name=Key Usage Extension Constraint
+policyset.serverCertSet.5.constraint.params.keyUsageCritical=true
+policyset.serverCertSet.5.constraint.params.keyUsageDigitalSignature=true
+policyset.serverCertSet.5.constraint.params.keyUsageKeyEncipherment=true
+policyset.serverCertSet.5.default.class_id=keyUsageExtDefaultImpl
+policyset.serverCertSet.5.default.name=Key Usage Default
+policyset.serverCertSet.5.default.params.keyUsageCritical=true
+policyset.serverCertSet.5.default.params.keyUsageDigitalSignature=true
+policyset.serverCertSet.5.default.params.keyUsageKeyEncipherment=true
+policyset.serverCertSet.6.constraint.class_id=signingAlgConstraintImpl
+policyset.serverCertSet.6.constraint.name=No Constraint
+policyset.serverCertSet.6.constraint.params.signingAlgsAllowed=SHA256withRSA,SHA384withRSA,SHA512withRSA,SHA256withEC,SHA384withEC,SHA512withEC
+policyset.serverCertSet.6.default.class_id=signingAlgDefaultImpl
+policyset.serverCertSet.6.default.name=Signing Alg
+policyset.serverCertSet.6.default.params.signingAlg=-
+EOT
+`, profileId, validity)
+}
+
+func TestAccFreeIPACertificateProfile_full(t *testing.T) {
+	testProfile := map[string]string{
+		"index":       "0",
+		"name":        "\"testaccProfile\"",
+		"description": "\"Certificate profile for acceptance tests\"",
+		"config":      testAccFreeIPACertificateProfileConfig("testaccProfile", 365),
+	}
+	testProfileModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testaccProfile\"",
+		"description": "\"Certificate profile for acceptance tests (modified)\"",
+		"store":       "false",
+		"config":      testAccFreeIPACertificateProfileConfig("testaccProfile", 180),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertificateProfile_resource(testProfile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certificate_profile.certprofile-0", "name", "testaccProfile"),
+					resource.TestCheckResourceAttr("freeipa_certificate_profile.certprofile-0", "description", "Certificate profile for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_certificate_profile.certprofile-0", "store", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertificateProfile_resource(testProfileModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certificate_profile.certprofile-0", "name", "testaccProfile"),
+					resource.TestCheckResourceAttr("freeipa_certificate_profile.certprofile-0", "description", "Certificate profile for acceptance tests (modified)"),
+					resource.TestCheckResourceAttr("freeipa_certificate_profile.certprofile-0", "store", "false"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertificateProfile_resource(testProfileModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPACaacl_categories(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"CA ACL for acceptance tests\"",
+	}
+	testCaaclModified := map[string]string{
+		"index":           "0",
+		"name":            "\"testacc-caacl\"",
+		"description":     "\"CA ACL for acceptance tests (modified)\"",
+		"enabled":         "false",
+		"usercategory":    "\"all\"",
+		"hostcategory":    "\"all\"",
+		"servicecategory": "\"all\"",
+		"profilecategory": "\"all\"",
+		"cacategory":      "\"all\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "description", "CA ACL for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaaclModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "description", "CA ACL for acceptance tests (modified)"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "enabled", "false"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "usercategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "hostcategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "servicecategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "profilecategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "cacategory", "all"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaaclModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPACaacl_memberships(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-group-0\"",
+		"description": "\"User group test 0\"",
+	}
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testProfile := map[string]string{
+		"index":       "0",
+		"name":        "\"testaccProfile\"",
+		"description": "\"Certificate profile for acceptance tests\"",
+		"config":      testAccFreeIPACertificateProfileConfig("testaccProfile", 365),
+	}
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"CA ACL for acceptance tests\"",
+	}
+	testUserMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"groups":     "[freeipa_group.group-0.name]",
+		"identifier": "\"users\"",
+	}
+	testHostMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"identifier": "\"hosts\"",
+	}
+	testProfileMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"profiles":   "[freeipa_certificate_profile.certprofile-0.name]",
+		"identifier": "\"profiles\"",
+	}
+	testCaMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"cas":        "[\"ipa\"]",
+		"identifier": "\"cas\"",
+	}
+	testProfileMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"profiles":   "[freeipa_certificate_profile.certprofile-0.name, \"caIPAserviceCert\"]",
+		"identifier": "\"profiles\"",
+	}
+
+	base := testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPACertificateProfile_resource(testProfile) + testAccFreeIPACaacl_resource(testCaacl)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPACaaclUserMembership_resource(testUserMembership) + testAccFreeIPACaaclHostMembership_resource(testHostMembership) + testAccFreeIPACaaclProfileMembership_resource(testProfileMembership) + testAccFreeIPACaaclCaMembership_resource(testCaMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "users.0", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "groups.0", "testacc-group-0"),
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_caacl_profile_membership.caacl-profile-membership-0", "profiles.0", "testaccProfile"),
+					resource.TestCheckResourceAttr("freeipa_caacl_ca_membership.caacl-ca-membership-0", "cas.0", "ipa"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPACaaclUserMembership_resource(testUserMembership) + testAccFreeIPACaaclHostMembership_resource(testHostMembership) + testAccFreeIPACaaclProfileMembership_resource(testProfileMembershipModified) + testAccFreeIPACaaclCaMembership_resource(testCaMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_profile_membership.caacl-profile-membership-0", "profiles.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_caacl_profile_membership.caacl-profile-membership-0", "profiles.1", "caIPAserviceCert"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPACaaclUserMembership_resource(testUserMembership) + testAccFreeIPACaaclHostMembership_resource(testHostMembership) + testAccFreeIPACaaclProfileMembership_resource(testProfileMembershipModified) + testAccFreeIPACaaclCaMembership_resource(testCaMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,257 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertificateProfileResource{}
+var _ resource.ResourceWithImportState = &CertificateProfileResource{}
+
+func NewCertificateProfileResource() resource.Resource {
+	return &CertificateProfileResource{}
+}
+
+// CertificateProfileResource defines the resource implementation.
+type CertificateProfileResource struct {
+	client *ipa.Client
+}
+
+// CertificateProfileResourceModel describes the resource data model.
+type CertificateProfileResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Store       types.Bool   `tfsdk:"store"`
+	Config      types.String `tfsdk:"config"`
+}
+
+func (r *CertificateProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_profile"
+}
+
+func (r *CertificateProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA certificate profile resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Profile ID for referring to this profile",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Brief description of this profile",
+				Required:            true,
+			},
+			"store": schema.BoolAttribute{
+				MarkdownDescription: "Whether to store certificates issued using this profile. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "Profile configuration content (Dogtag `.cfg` format). The `profileId` of the configuration must match `name`.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *CertificateProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificateProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CertprofileImportOptionalArgs{
+		Ipacertprofilestoreissued: data.Store.ValueBoolPointer(),
+	}
+
+	args := ipa.CertprofileImportArgs{
+		Cn:          data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		File:        data.Config.ValueString(),
+	}
+
+	_, err := r.client.CertprofileImport(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa certificate profile: %s", err))
+		return
+	}
+
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.CertprofileShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CertprofileShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.CertprofileShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Certificate profile not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certificate profile: %s", err))
+			return
+		}
+	}
+
+	data.Name = types.StringValue(res.Result.Cn)
+	if res.Result.Description != nil {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipacertprofilestoreissued != nil {
+		data.Store = types.BoolValue(*res.Result.Ipacertprofilestoreissued)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CertificateProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CertificateProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CertprofileModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.CertprofileModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = data.Description.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Store.Equal(state.Store) {
+		optArgs.Ipacertprofilestoreissued = data.Store.ValueBoolPointer()
+		hasChange = true
+	}
+	if !data.Config.Equal(state.Config) {
+		optArgs.File = data.Config.ValueStringPointer()
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.CertprofileMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa certificate profile: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CertprofileDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.CertprofileDel(&args, &ipa.CertprofileDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa certificate profile: %s", err))
+		return
+	}
+}
+
+func (r *CertificateProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,507 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithImportState = &CertificateResource{}
+var _ resource.ResourceWithModifyPlan = &CertificateResource{}
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+// CertificateResource defines the resource implementation.
+type CertificateResource struct {
+	client *ipa.Client
+}
+
+// CertificateResourceModel describes the resource data model.
+type CertificateResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Principal         types.String `tfsdk:"principal"`
+	Csr               types.String `tfsdk:"csr"`
+	Profile           types.String `tfsdk:"profile"`
+	Ca                types.String `tfsdk:"ca"`
+	RevocationReason  types.Int64  `tfsdk:"revocation_reason"`
+	EarlyRenewalHours types.Int64  `tfsdk:"early_renewal_hours"`
+	Certificate       types.String `tfsdk:"certificate"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	Subject           types.String `tfsdk:"subject"`
+	Issuer            types.String `tfsdk:"issuer"`
+	ValidNotBefore    types.String `tfsdk:"valid_not_before"`
+	ValidNotAfter     types.String `tfsdk:"valid_not_after"`
+	ReadyForRenewal   types.Bool   `tfsdk:"ready_for_renewal"`
+}
+
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA certificate resource.\n\n" +
+			"Submits a certificate signing request for a host, service or user principal. " +
+			"The certificate is revoked when the resource is destroyed.\n\n" +
+			"When `early_renewal_hours` is set, the certificate is replaced once it expires within that number of hours.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				MarkdownDescription: "Principal the certificate is issued for (e.g. `host/host01.example.lan`, `HTTP/www.example.lan` or `jdoe`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"csr": schema.StringAttribute{
+				MarkdownDescription: "Certificate signing request in PEM format. The certificate signing request cannot be read back from the server, setting it on an imported certificate does not replace the certificate.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// An imported certificate has no csr in the state
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Replace the certificate when the certificate signing request changes, except on imported certificates.",
+						"Replace the certificate when the certificate signing request changes, except on imported certificates.",
+					),
+				},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Certificate profile to use (e.g. `caIPAserviceCert`). The server default profile is used when not set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ca": schema.StringAttribute{
+				MarkdownDescription: "Name of the issuing certificate authority. Defaults to `ipa`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ipa"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revocation_reason": schema.Int64Attribute{
+				MarkdownDescription: "Reason used to revoke the certificate on destroy. Defaults to `0`.\n\n" +
+					"	- 0: unspecified\n" +
+					"	- 1: keyCompromise\n" +
+					"	- 2: cACompromise\n" +
+					"	- 3: affiliationChanged\n" +
+					"	- 4: superseded\n" +
+					"	- 5: cessationOfOperation\n" +
+					"	- 6: certificateHold\n" +
+					"	- 8: removeFromCRL\n" +
+					"	- 9: privilegeWithdrawn\n" +
+					"	- 10: aACompromise",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2, 3, 4, 5, 6, 8, 9, 10),
+				},
+			},
+			"early_renewal_hours": schema.Int64Attribute{
+				MarkdownDescription: "Number of hours before the certificate expiry from which the certificate is replaced. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				MarkdownDescription: "Issued certificate in PEM format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "Serial number of the issued certificate, in decimal.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the issued certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "Issuer of the issued certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid_not_before": schema.StringAttribute{
+				MarkdownDescription: "Start of the certificate validity (RFC3339)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid_not_after": schema.StringAttribute{
+				MarkdownDescription: "Expiry of the certificate (RFC3339)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ready_for_renewal": schema.BoolAttribute{
+				MarkdownDescription: "True when the certificate expires within `early_renewal_hours` and will be replaced. Computed when the state is refreshed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state, data CertificateResourceModel
+
+	// on create or delete
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the renewal window is computed on read, a certificate in the window is replaced
+	readyForRenewal := state.ReadyForRenewal.ValueBool()
+	// a new renewal window is checked against the current certificate, the flag itself is only updated on read
+	if !readyForRenewal && !data.EarlyRenewalHours.IsUnknown() && !data.EarlyRenewalHours.Equal(state.EarlyRenewalHours) {
+		notAfter, err := time.Parse(time.RFC3339, state.ValidNotAfter.ValueString())
+		if err == nil {
+			readyForRenewal = isCertificateReadyForRenewal(notAfter, data.EarlyRenewalHours.ValueInt64())
+		}
+	}
+	if readyForRenewal {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Certificate %s expires on %s and is ready for renewal", state.Id.ValueString(), state.ValidNotAfter.ValueString()))
+		data.ReadyForRenewal = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("ready_for_renewal"))
+	}
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CertRequestOptionalArgs{
+		Cacn: data.Ca.ValueStringPointer(),
+	}
+
+	args := ipa.CertRequestArgs{
+		Csr:       data.Csr.ValueString(),
+		Principal: data.Principal.ValueString(),
+	}
+	if !data.Profile.IsNull() {
+		optArgs.ProfileID = data.Profile.ValueStringPointer()
+	}
+
+	res, err := r.client.CertRequest(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa certificate: %s", err))
+		return
+	}
+
+	serial, err := certificateSerialNumber(res.Result)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing freeipa certificate serial number: %s", err))
+		return
+	}
+	data.SerialNumber = types.StringValue(serial)
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", encodeSlash(data.Ca.ValueString()), serial))
+	_, err = setCertificateData(&data, res.Result.Certificate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing freeipa certificate %s: %s", serial, err))
+		return
+	}
+	// The renewal window is evaluated when the state is refreshed
+	data.ReadyForRenewal = types.BoolValue(false)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ca, serial, err := parseCertificateID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_certificate: %s", err))
+		return
+	}
+
+	res, err := r.client.CertShow(&ipa.CertShowArgs{SerialNumber: serial}, &ipa.CertShowOptionalArgs{Cacn: &ca})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Certificate not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certificate: %s", err))
+			return
+		}
+	}
+	if res.Result.Revoked != nil && *res.Result.Revoked {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Certificate %d has been revoked", serial))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Ca = types.StringValue(ca)
+	data.SerialNumber = types.StringValue(strconv.Itoa(serial))
+	cert, err := setCertificateData(&data, res.Result.Certificate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing freeipa certificate %d: %s", serial, err))
+		return
+	}
+	data.ReadyForRenewal = types.BoolValue(isCertificateReadyForRenewal(cert.NotAfter, data.EarlyRenewalHours.ValueInt64()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the revocation reason, the renewal window and the csr of an imported certificate can be updated, they are local to the state.
+	// The computed attributes are kept from the plan, ready_for_renewal is only evaluated on read.
+	data.Id = state.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ca, serial, err := parseCertificateID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_certificate: %s", err))
+		return
+	}
+
+	reason := int(data.RevocationReason.ValueInt64())
+	_, err = r.client.CertRevoke(&ipa.CertRevokeArgs{SerialNumber: serial}, &ipa.CertRevokeOptionalArgs{RevocationReason: &reason, Cacn: &ca})
+	if err != nil {
+		if strings.Contains(err.Error(), "already revoked") {
+			resp.Diagnostics.AddWarning("Client Warning", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error revoke freeipa certificate: %s", err))
+			return
+		}
+	}
+}
+
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ca, serial, err := parseCertificateID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	res, err := r.client.CertShow(&ipa.CertShowArgs{SerialNumber: serial}, &ipa.CertShowOptionalArgs{All: &all, Cacn: &ca})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa certificate: %s", err))
+		return
+	}
+	principal := certificatePrincipal(res.Result)
+	if principal == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to determine the principal of the freeipa certificate %d", serial))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%d", encodeSlash(ca), serial))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal"), principal)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ca"), ca)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial_number"), strconv.Itoa(serial))...)
+	// The revocation reason and the renewal window are local to the state, they are imported with their default values.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("revocation_reason"), int64(0))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("early_renewal_hours"), int64(0))...)
+}
+
+// setCertificateData fills the computed attributes of the model from the certificate returned by the server,
+// either base64 DER encoded or in PEM format.
+func setCertificateData(data *CertificateResourceModel, certificate string) (*x509.Certificate, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(certificate)); block != nil {
+		der = block.Bytes
+	} else {
+		b, err := base64.StdEncoding.DecodeString(certificate)
+		if err != nil {
+			return nil, err
+		}
+		der = b
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Certificate = types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	data.Subject = types.StringValue(cert.Subject.String())
+	data.Issuer = types.StringValue(cert.Issuer.String())
+	data.ValidNotBefore = types.StringValue(cert.NotBefore.UTC().Format(time.RFC3339))
+	data.ValidNotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	return cert, nil
+}
+
+func isCertificateReadyForRenewal(notAfter time.Time, earlyRenewalHours int64) bool {
+	renewal := time.Duration(earlyRenewalHours) * time.Hour
+	return earlyRenewalHours > 0 && time.Now().Add(renewal).After(notAfter)
+}
+
+// certificateSerialNumber returns the decimal serial number of a certificate.
+// The hexadecimal value is preferred as random serial numbers are 128 bits long and do not fit in an integer.
+func certificateSerialNumber(cert ipa.Cert) (string, error) {
+	if cert.SerialNumberHex != nil {
+		return parseCertificateSerialNumber(*cert.SerialNumberHex)
+	}
+	return parseCertificateSerialNumber(fmt.Sprintf("%d", cert.SerialNumber))
+}
+
+// parseCertificateSerialNumber parses a serial number in decimal, or in hexadecimal when prefixed with 0x, and returns it in decimal.
+func parseCertificateSerialNumber(serial string) (string, error) {
+	n, ok := new(big.Int).SetString(serial, 0)
+	if !ok || n.Sign() < 0 {
+		return "", fmt.Errorf("invalid certificate serial number %s", serial)
+	}
+	return n.String(), nil
+}
+
+// certificatePrincipal returns the principal owning the certificate, in the format used by the principal attribute.
+func certificatePrincipal(cert ipa.Cert) string {
+	if cert.OwnerService != nil && len(*cert.OwnerService) > 0 {
+		principal, _, _ := strings.Cut((*cert.OwnerService)[0], "@")
+		return principal
+	}
+	if cert.OwnerHost != nil && len(*cert.OwnerHost) > 0 {
+		return "host/" + (*cert.OwnerHost)[0]
+	}
+	if cert.OwnerUser != nil && len(*cert.OwnerUser) > 0 {
+		return (*cert.OwnerUser)[0]
+	}
+	return ""
+}
+
+// parseCertificateID returns the CA and the serial number of a certificate ID.
+// The serial number is returned as an integer as the certificate commands of the FreeIPA client take an integer serial number.
+func parseCertificateID(id string) (string, int, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 {
+		return "", 0, fmt.Errorf("unable to determine certificate ID %s", id)
+	}
+	decimal, err := parseCertificateSerialNumber(idParts[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("unable to determine certificate serial number %s", idParts[1])
+	}
+	serial, err := strconv.Atoi(decimal)
+	if err != nil {
+		return "", 0, fmt.Errorf("certificate serial number %s exceeds the integer range supported by the FreeIPA client", idParts[1])
+	}
+
+	return decodeSlash(idParts[0]), serial, nil
+}
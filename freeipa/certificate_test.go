@@ -0,0 +1,118 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// testAccFreeIPACertificateCSR returns a PEM encoded certificate signing request as a heredoc string.
+func testAccFreeIPACertificateCSR(t *testing.T, commonName string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: commonName},
+		DNSNames: []string{commonName},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf("<<EOT\n%sEOT\n", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}))
+}
+
+func TestAccFreeIPACertificate_host(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	csr := testAccFreeIPACertificateCSR(t, "testacc-host-1.testacc.ipatest.lan")
+	testCertificate := map[string]string{
+		"index":     "0",
+		"principal": "\"host/${freeipa_host.host-0.name}\"",
+		"csr":       csr,
+	}
+	testCertificateModified := map[string]string{
+		"index":             "0",
+		"principal":         "\"host/${freeipa_host.host-0.name}\"",
+		"csr":               csr,
+		"revocation_reason": "4",
+	}
+	testCertificateRenewal := map[string]string{
+		"index":               "0",
+		"principal":           "\"host/${freeipa_host.host-0.name}\"",
+		"csr":                 csr,
+		"revocation_reason":   "4",
+		"early_renewal_hours": "87600",
+	}
+
+	base := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPACertificate_resource(testCertificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "ca", "ipa"),
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "revocation_reason", "0"),
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "ready_for_renewal", "false"),
+					resource.TestCheckResourceAttrSet("freeipa_certificate.certificate-0", "serial_number"),
+					resource.TestCheckResourceAttrSet("freeipa_certificate.certificate-0", "valid_not_after"),
+					resource.TestMatchResourceAttr("freeipa_certificate.certificate-0", "certificate", regexp.MustCompile("^-----BEGIN CERTIFICATE-----")),
+					resource.TestMatchResourceAttr("freeipa_certificate.certificate-0", "subject", regexp.MustCompile("CN=testacc-host-1.testacc.ipatest.lan")),
+				),
+			},
+			{
+				Config: base + testAccFreeIPACertificate_resource(testCertificateModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "revocation_reason", "4"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPACertificate_resource(testCertificateModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: base + testAccFreeIPACertificate_resource(testCertificateRenewal),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "early_renewal_hours", "87600"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_certificate.certificate-0", plancheck.ResourceActionReplace),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_certificate.certificate-0", plancheck.ResourceActionReplace),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
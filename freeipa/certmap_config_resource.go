@@ -0,0 +1,192 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// The certmap configuration always exists and cannot be created or deleted, it is only modified.
+const certmapConfigId = "certmap_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertmapConfigResource{}
+var _ resource.ResourceWithImportState = &CertmapConfigResource{}
+
+func NewCertmapConfigResource() resource.Resource {
+	return &CertmapConfigResource{}
+}
+
+// CertmapConfigResource defines the resource implementation.
+type CertmapConfigResource struct {
+	client *ipa.Client
+}
+
+// CertmapConfigResourceModel describes the resource data model.
+type CertmapConfigResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	PromptUsername types.Bool   `tfsdk:"prompt_username"`
+}
+
+func (r *CertmapConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certmap_config"
+}
+
+func (r *CertmapConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA global certificate identity mapping configuration resource.\nThe configuration always exists: it is only modified, it is never created nor deleted (destroying the resource only removes it from the state).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource, always `certmap_config`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prompt_username": schema.BoolAttribute{
+				MarkdownDescription: "Prompt for the username when multiple identities are mapped to a certificate",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *CertmapConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertmapConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertmapConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration always exists, creating it means modifying it.
+	optArgs := ipa.CertmapconfigModOptionalArgs{
+		Ipacertmappromptusername: data.PromptUsername.ValueBoolPointer(),
+	}
+	_, err := r.client.CertmapconfigMod(&ipa.CertmapconfigModArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddWarning("Client Warning", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa certmap config: %s", err))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(certmapConfigId)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertmapConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertmapConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	res, err := r.client.CertmapconfigShow(&ipa.CertmapconfigShowArgs{}, &ipa.CertmapconfigShowOptionalArgs{All: &all})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certmap config: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa certmap config %s", res.Result.String()))
+
+	if res.Result.Ipacertmappromptusername != nil {
+		data.PromptUsername = types.BoolValue(*res.Result.Ipacertmappromptusername)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CertmapConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CertmapConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PromptUsername.Equal(state.PromptUsername) {
+		optArgs := ipa.CertmapconfigModOptionalArgs{
+			Ipacertmappromptusername: data.PromptUsername.ValueBoolPointer(),
+		}
+		_, err := r.client.CertmapconfigMod(&ipa.CertmapconfigModArgs{}, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa certmap config: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = state.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertmapConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "[DEBUG] The certmap config cannot be deleted, removing it from the state only")
+}
+
+func (r *CertmapConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != certmapConfigId {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("The import ID of the certmap config must be %q", certmapConfigId))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prompt_username"), false)...)
+}
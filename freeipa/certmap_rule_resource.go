@@ -0,0 +1,389 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertmapRuleResource{}
+var _ resource.ResourceWithImportState = &CertmapRuleResource{}
+
+func NewCertmapRuleResource() resource.Resource {
+	return &CertmapRuleResource{}
+}
+
+// CertmapRuleResource defines the resource implementation.
+type CertmapRuleResource struct {
+	client *ipa.Client
+}
+
+// CertmapRuleResourceModel describes the resource data model.
+type CertmapRuleResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	MapRule     types.String `tfsdk:"map_rule"`
+	MatchRule   types.String `tfsdk:"match_rule"`
+	Domains     types.List   `tfsdk:"domains"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *CertmapRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certmap_rule"
+}
+
+func (r *CertmapRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA certificate identity mapping rule resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Certificate Identity Mapping Rule name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Certificate Identity Mapping Rule description",
+				Optional:            true,
+			},
+			"map_rule": schema.StringAttribute{
+				MarkdownDescription: "Rule used to map the certificate with a user entry (ie: `(ipacertmapdata=X509:<I>{issuer_dn!nss_x500}<S>{subject_dn!nss_x500})`)",
+				Optional:            true,
+			},
+			"match_rule": schema.StringAttribute{
+				MarkdownDescription: "Rule used to check if a certificate can be used for authentication (ie: `<ISSUER>CN=Smart Card CA,O=EXAMPLE.LAN`)",
+				Optional:            true,
+			},
+			"domains": schema.ListAttribute{
+				MarkdownDescription: "Domains where the user entries are searched. Defaults to the IPA domain.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the rule (higher number means lower priority)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this rule",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *CertmapRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertmapRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertmapRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CertmapruleAddOptionalArgs{}
+
+	args := ipa.CertmapruleAddArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.MapRule.IsNull() {
+		optArgs.Ipacertmapmaprule = data.MapRule.ValueStringPointer()
+	}
+	if !data.MatchRule.IsNull() {
+		optArgs.Ipacertmapmatchrule = data.MatchRule.ValueStringPointer()
+	}
+	if len(data.Domains.Elements()) > 0 {
+		var v []string
+		for _, value := range data.Domains.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Associateddomain = &v
+	}
+	if !data.Priority.IsNull() {
+		v := int(data.Priority.ValueInt64())
+		optArgs.Ipacertmappriority = &v
+	}
+	if !data.Enabled.IsNull() {
+		v := data.Enabled.ValueBool()
+		optArgs.Ipaenabledflag = &v
+	}
+
+	_, err := r.client.CertmapruleAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa certmap rule: %s", err))
+		return
+	}
+
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertmapRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertmapRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.CertmapruleShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CertmapruleShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.CertmapruleShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Certmap rule not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certmap rule: %s", err))
+			return
+		}
+	}
+
+	data.Name = types.StringValue(res.Result.Cn)
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipacertmapmaprule != nil && !data.MapRule.IsNull() {
+		data.MapRule = types.StringValue(*res.Result.Ipacertmapmaprule)
+	}
+	if res.Result.Ipacertmapmatchrule != nil && !data.MatchRule.IsNull() {
+		data.MatchRule = types.StringValue(*res.Result.Ipacertmapmatchrule)
+	}
+	if res.Result.Associateddomain != nil && !data.Domains.IsNull() {
+		var diag diag.Diagnostics
+		data.Domains, diag = types.ListValueFrom(ctx, types.StringType, res.Result.Associateddomain)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Ipacertmappriority != nil && !data.Priority.IsNull() {
+		data.Priority = types.Int64Value(int64(*res.Result.Ipacertmappriority))
+	}
+	if res.Result.Ipaenabledflag != nil && !data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(*res.Result.Ipaenabledflag)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CertmapRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CertmapRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CertmapruleModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.CertmapruleModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.MapRule.Equal(state.MapRule) {
+		if data.MapRule.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Ipacertmapmaprule = &v
+		} else {
+			optArgs.Ipacertmapmaprule = data.MapRule.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.MatchRule.Equal(state.MatchRule) {
+		if data.MatchRule.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Ipacertmapmatchrule = &v
+		} else {
+			optArgs.Ipacertmapmatchrule = data.MatchRule.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Domains.Equal(state.Domains) {
+		v := []string{}
+		for _, value := range data.Domains.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Associateddomain = &v
+		hasChange = true
+	}
+	if !data.Priority.Equal(state.Priority) && !data.Priority.IsNull() {
+		v := int(data.Priority.ValueInt64())
+		optArgs.Ipacertmappriority = &v
+		hasChange = true
+	}
+	if !data.Enabled.Equal(state.Enabled) {
+		if !data.Enabled.ValueBool() {
+			_, err := r.client.CertmapruleDisable(&ipa.CertmapruleDisableArgs{Cn: data.Id.ValueString()}, &ipa.CertmapruleDisableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disabling freeipa certmap rule: %s", err))
+			}
+		} else {
+			_, err := r.client.CertmapruleEnable(&ipa.CertmapruleEnableArgs{Cn: data.Id.ValueString()}, &ipa.CertmapruleEnableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error enabling freeipa certmap rule: %s", err))
+			}
+		}
+	}
+
+	if hasChange {
+		_, err := r.client.CertmapruleMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa certmap rule: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertmapRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertmapRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CertmapruleDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.CertmapruleDel(&args, &ipa.CertmapruleDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa certmap rule: %s", err))
+		return
+	}
+}
+
+func (r *CertmapRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	all := true
+	res, err := r.client.CertmapruleShow(&ipa.CertmapruleShowArgs{Cn: req.ID}, &ipa.CertmapruleShowOptionalArgs{All: &all})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa certmap rule: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), *res.Result.Description)...)
+	}
+	if res.Result.Ipacertmapmaprule != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("map_rule"), *res.Result.Ipacertmapmaprule)...)
+	}
+	if res.Result.Ipacertmapmatchrule != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("match_rule"), *res.Result.Ipacertmapmatchrule)...)
+	}
+	if res.Result.Associateddomain != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domains"), *res.Result.Associateddomain)...)
+	}
+	if res.Result.Ipacertmappriority != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("priority"), int64(*res.Result.Ipacertmappriority))...)
+	}
+	if res.Result.Ipaenabledflag != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), *res.Result.Ipaenabledflag)...)
+	}
+}
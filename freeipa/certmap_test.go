@@ -0,0 +1,155 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACertmapRule_full(t *testing.T) {
+	testRule := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-smartcard\"",
+		"match_rule": "\"<ISSUER>CN=Smart Card CA,O=IPATEST.LAN\"",
+		"map_rule":   "\"(ipacertmapdata=X509:<I>{issuer_dn!nss_x500}<S>{subject_dn!nss_x500})\"",
+	}
+	testRuleModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-smartcard\"",
+		"description": "\"Smart card login for administrators\"",
+		"match_rule":  "\"<ISSUER>CN=Smart Card CA,O=IPATEST.LAN<KU>digitalSignature\"",
+		"map_rule":    "\"(ipacertmapdata=X509:<I>{issuer_dn!nss_x500}<S>{subject_dn!nss_x500})\"",
+		"domains":     "[\"ipatest.lan\"]",
+		"priority":    "10",
+		"enabled":     "false",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertmapRule_resource(testRule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "name", "testacc-smartcard"),
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "match_rule", "<ISSUER>CN=Smart Card CA,O=IPATEST.LAN"),
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertmapRule_resource(testRuleModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "description", "Smart card login for administrators"),
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "match_rule", "<ISSUER>CN=Smart Card CA,O=IPATEST.LAN<KU>digitalSignature"),
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "domains.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "domains.0", "ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "priority", "10"),
+					resource.TestCheckResourceAttr("freeipa_certmap_rule.certmap-rule-0", "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertmapRule_resource(testRuleModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPACertmapConfig_full(t *testing.T) {
+	testConfig := map[string]string{
+		"prompt_username": "false",
+	}
+	testConfigModified := map[string]string{
+		"prompt_username": "true",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertmapConfig_resource(testConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certmap_config.certmap-config", "id", "certmap_config"),
+					resource.TestCheckResourceAttr("freeipa_certmap_config.certmap-config", "prompt_username", "false"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertmapConfig_resource(testConfigModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certmap_config.certmap-config", "prompt_username", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertmapConfig_resource(testConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certmap_config.certmap-config", "prompt_username", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAUserCertmapdata_full(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testCertmapdata := map[string]string{
+		"index":   "0",
+		"user":    "freeipa_user.user-0.name",
+		"issuer":  "\"CN=Smart Card CA,O=IPATEST.LAN\"",
+		"subject": "\"CN=testacc-user-0,O=IPATEST.LAN\"",
+	}
+	testCertmapdataModified := map[string]string{
+		"index":   "0",
+		"user":    "freeipa_user.user-0.name",
+		"issuer":  "\"CN=Smart Card CA,O=IPATEST.LAN\"",
+		"subject": "\"CN=Test User0,O=IPATEST.LAN\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserCertmapdata_resource(testCertmapdata),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_user_certmapdata.user-certmapdata-0", "user", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_user_certmapdata.user-certmapdata-0", "certmapdata", "X509:<I>O=IPATEST.LAN,CN=Smart Card CA<S>O=IPATEST.LAN,CN=testacc-user-0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserCertmapdata_resource(testCertmapdataModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_user_certmapdata.user-certmapdata-0", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_user_certmapdata.user-certmapdata-0", "certmapdata", "X509:<I>O=IPATEST.LAN,CN=Smart Card CA<S>O=IPATEST.LAN,CN=Test User0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserCertmapdata_resource(testCertmapdataModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -35,6 +35,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserGroupResource{}
+var _ resource.ResourceWithModifyPlan = &UserGroupResource{}
 
 // var _ resource.ResourceWithImportState = &UserGroupResource{}
 
@@ -166,6 +167,28 @@ func (r *UserGroupResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *UserGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state, data UserGroupResourceModel
+
+	// on delete
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// warn when the gid is not part of any local id range
+	if !data.GidNumber.IsNull() && !data.GidNumber.IsUnknown() && !data.GidNumber.Equal(state.GidNumber) {
+		resp.Diagnostics.Append(checkIdInLocalIdRanges(ctx, r.client, path.Root("gid_number"), data.GidNumber.ValueInt64())...)
+	}
+}
+
 func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data UserGroupResourceModel
 
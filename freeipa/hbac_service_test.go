@@ -0,0 +1,57 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAHbacService_simple(t *testing.T) {
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hbacsvc\"",
+	}
+	testServiceModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-hbacsvc\"",
+		"description": "\"A HBAC service for acceptance tests\"",
+	}
+	testServiceDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_hbac_service.hbac-service-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testService),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_service.hbac-service-0", "name", "testacc-hbacsvc"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testServiceModified) + testAccFreeIPAHbacService_datasource(testServiceDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_service.hbac-service-0", "description", "A HBAC service for acceptance tests"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_service.hbac-service-0", "description", "A HBAC service for acceptance tests"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testServiceModified) + testAccFreeIPAHbacService_datasource(testServiceDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAHbacServiceGroup_membership(t *testing.T) {
+	testService0 := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hbacsvc-0\"",
+	}
+	testService1 := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-hbacsvc-1\"",
+	}
+	testServiceGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-hbacsvcgroup\"",
+		"description": "\"A HBAC service group for acceptance tests\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_hbac_servicegroup.hbac-servicegroup-0.name",
+		"services":   "[freeipa_hbac_service.hbac-service-0.name]",
+		"identifier": "\"services-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_hbac_servicegroup.hbac-servicegroup-0.name",
+		"services":   "[freeipa_hbac_service.hbac-service-0.name, freeipa_hbac_service.hbac-service-1.name]",
+		"identifier": "\"services-0\"",
+	}
+	testServiceGroupDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_hbac_servicegroup.hbac-servicegroup-0.name",
+	}
+	testServiceDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_hbac_service.hbac-service-0.name",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testService0) + testAccFreeIPAHbacService_resource(testService1) + testAccFreeIPAHbacServiceGroup_resource(testServiceGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPAHbacServiceGroupMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup.hbac-servicegroup-0", "description", "A HBAC service group for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbac-servicegroup-membership-0", "services.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbac-servicegroup-membership-0", "services.0", "testacc-hbacsvc-0"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAHbacServiceGroupMembership_resource(testMembershipModified) + testAccFreeIPAHbacServiceGroup_datasource(testServiceGroupDS) + testAccFreeIPAHbacService_datasource(testServiceDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbac-servicegroup-membership-0", "services.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_servicegroup.hbac-servicegroup-0", "member_hbacsvc.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_service.hbac-service-0", "memberof_hbacsvcgroup.0", "testacc-hbacsvcgroup"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAHbacServiceGroupMembership_resource(testMembershipModified) + testAccFreeIPAHbacServiceGroup_datasource(testServiceGroupDS) + testAccFreeIPAHbacService_datasource(testServiceDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
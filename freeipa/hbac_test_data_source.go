@@ -0,0 +1,194 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HbacTestDataSource{}
+var _ datasource.DataSourceWithConfigure = &HbacTestDataSource{}
+
+func NewHbacTestDataSource() datasource.DataSource {
+	return &HbacTestDataSource{}
+}
+
+// HbacTestDataSource defines the data source implementation.
+type HbacTestDataSource struct {
+	client *ipa.Client
+}
+
+// HbacTestDataSourceModel describes the data source data model.
+type HbacTestDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	User            types.String `tfsdk:"user"`
+	TargetHost      types.String `tfsdk:"targethost"`
+	Service         types.String `tfsdk:"service"`
+	Rules           types.List   `tfsdk:"rules"`
+	AccessGranted   types.Bool   `tfsdk:"access_granted"`
+	MatchedRules    types.List   `tfsdk:"matched_rules"`
+	NotMatchedRules types.List   `tfsdk:"notmatched_rules"`
+	ErrorRules      types.List   `tfsdk:"error_rules"`
+}
+
+func (r *HbacTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_test"
+}
+
+func (r *HbacTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA HBAC test data source.\nSimulates the access of a user to a host through a service using the HBAC rules (`hbactest`).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User name to test the access for",
+				Required:            true,
+			},
+			"targethost": schema.StringAttribute{
+				MarkdownDescription: "Target host to test the access to",
+				Required:            true,
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "HBAC service used to access the target host",
+				Required:            true,
+			},
+			"rules": schema.ListAttribute{
+				MarkdownDescription: "List of HBAC rules to test. All the enabled rules are tested if not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"access_granted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the access is granted",
+				Computed:            true,
+			},
+			"matched_rules": schema.ListAttribute{
+				MarkdownDescription: "List of HBAC rules that matched",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"notmatched_rules": schema.ListAttribute{
+				MarkdownDescription: "List of HBAC rules that did not match",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"error_rules": schema.ListAttribute{
+				MarkdownDescription: "List of HBAC rules that could not be evaluated (e.g. unknown rule names)",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *HbacTestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HbacTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HbacTestDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.HbactestArgs{
+		User:       data.User.ValueString(),
+		Targethost: data.TargetHost.ValueString(),
+		Service:    data.Service.ValueString(),
+	}
+	optArgs := ipa.HbactestOptionalArgs{}
+	if !data.Rules.IsNull() {
+		var v []string
+		for _, value := range data.Rules.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Rules = &v
+	}
+
+	res, err := r.client.Hbactest(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa hbac test %s", res.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa hbac test for user %s", data.User.ValueString()))
+		return
+	}
+
+	var diag diag.Diagnostics
+	data.AccessGranted = types.BoolValue(res.Value)
+	data.MatchedRules, diag = types.ListValueFrom(ctx, types.StringType, hbacTestRuleList(res.Matched))
+	resp.Diagnostics.Append(diag...)
+	data.NotMatchedRules, diag = types.ListValueFrom(ctx, types.StringType, hbacTestRuleList(res.Notmatched))
+	resp.Diagnostics.Append(diag...)
+	data.ErrorRules, diag = types.ListValueFrom(ctx, types.StringType, hbacTestRuleList(res.Error))
+	resp.Diagnostics.Append(diag...)
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", data.User.ValueString(), data.TargetHost.ValueString(), data.Service.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// The hbactest command returns the rule lists as untyped values that are null when empty.
+func hbacTestRuleList(rules interface{}) []string {
+	res := []string{}
+	switch v := rules.(type) {
+	case []string:
+		res = append(res, v...)
+	case *[]string:
+		if v != nil {
+			res = append(res, *v...)
+		}
+	case []interface{}:
+		for _, rule := range v {
+			res = append(res, fmt.Sprintf("%v", rule))
+		}
+	case string:
+		res = append(res, v)
+	}
+	return res
+}
@@ -0,0 +1,97 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAHbacTest_simple(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testDeniedUser := map[string]string{
+		"index":     "1",
+		"login":     "\"testacc-user-1\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User1\"",
+	}
+	testHbacPolicy := map[string]string{
+		"index":           "1",
+		"name":            "\"testacc-hbac-policy\"",
+		"description":     "\"A hbac policy for acceptance tests\"",
+		"servicecategory": "\"all\"",
+	}
+	testHbacUserMembership := map[string]string{
+		"index": "1",
+		"name":  "freeipa_hbac_policy.hbacpolicy-1.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+	testHbacHostMembership := map[string]string{
+		"index": "1",
+		"name":  "freeipa_hbac_policy.hbacpolicy-1.name",
+		"host":  "freeipa_host.host-0.name",
+	}
+	testHbacTestGranted := map[string]string{
+		"index":      "0",
+		"user":       "freeipa_hbac_policy_user_membership.hbac-user-membership-1.user",
+		"targethost": "freeipa_hbac_policy_host_membership.hbac-host-membership-1.host",
+		"service":    "\"sshd\"",
+		"rules":      "[freeipa_hbac_policy.hbacpolicy-1.name]",
+	}
+	testHbacTestDenied := map[string]string{
+		"index":      "1",
+		"user":       "freeipa_user.user-1.name",
+		"targethost": "freeipa_hbac_policy_host_membership.hbac-host-membership-1.host",
+		"service":    "\"sshd\"",
+		"rules":      "[freeipa_hbac_policy.hbacpolicy-1.name]",
+	}
+
+	testConfig := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUser_resource(testDeniedUser) + testAccFreeIPAHbacPolicy_resource(testHbacPolicy) + testAccFreeIPAHbacPolicyUserMembership_resource(testHbacUserMembership) + testAccFreeIPAHbacPolicyHostMembership_resource(testHbacHostMembership)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig + testAccFreeIPAHbacTest_datasource(testHbacTestGranted) + testAccFreeIPAHbacTest_datasource(testHbacTestDenied),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbac-test-0", "access_granted", "true"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbac-test-0", "matched_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbac-test-0", "matched_rules.0", "testacc-hbac-policy"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbac-test-0", "notmatched_rules.#", "0"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbac-test-1", "access_granted", "false"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbac-test-1", "matched_rules.#", "0"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbac-test-1", "notmatched_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbac-test-1", "notmatched_rules.0", "testacc-hbac-policy"),
+				),
+			},
+			{
+				Config: testConfig + testAccFreeIPAHbacTest_datasource(testHbacTestGranted) + testAccFreeIPAHbacTest_datasource(testHbacTestDenied),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
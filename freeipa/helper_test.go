@@ -177,6 +177,21 @@ func testAccFreeIPAUser_resource(dataset map[string]string) string {
 	if dataset["setattr"] != "" {
 		tf_def += fmt.Sprintf("  setattr = %s\n", dataset["setattr"])
 	}
+	if dataset["auth_type"] != "" {
+		tf_def += fmt.Sprintf("  auth_type = %s\n", dataset["auth_type"])
+	}
+	if dataset["radius_proxy_config"] != "" {
+		tf_def += fmt.Sprintf("  radius_proxy_config = %s\n", dataset["radius_proxy_config"])
+	}
+	if dataset["radius_proxy_username"] != "" {
+		tf_def += fmt.Sprintf("  radius_proxy_username = %s\n", dataset["radius_proxy_username"])
+	}
+	if dataset["external_idp_config"] != "" {
+		tf_def += fmt.Sprintf("  external_idp_config = %s\n", dataset["external_idp_config"])
+	}
+	if dataset["external_idp_username"] != "" {
+		tf_def += fmt.Sprintf("  external_idp_username = %s\n", dataset["external_idp_username"])
+	}
 	tf_def += "}\n"
 	return tf_def
 }
@@ -546,6 +561,15 @@ func testAccFreeIPASudoRuleOption_resource(dataset map[string]string) string {
 	return tf_def
 }
 
+func testAccFreeIPASudoRuleOptions_resource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	resource "freeipa_sudo_rule_options" "sudorule-options-%s" {
+	  name    = %s
+	  options = %s
+	}
+	`, dataset["index"], dataset["name"], dataset["options"])
+}
+
 func testAccFreeIPASudoRule_datasource(dataset map[string]string) string {
 	return fmt.Sprintf(`
 	data "freeipa_sudo_rule" "sudorule-%s" {
@@ -619,6 +643,9 @@ func testAccFreeIPASudoRuleHostMembership_resource(dataset map[string]string) st
 	if dataset["hostgroups"] != "" {
 		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
 	}
+	if dataset["external_hosts"] != "" {
+		tf_def += fmt.Sprintf("  external_hosts = %s\n", dataset["external_hosts"])
+	}
 	if dataset["identifier"] != "" {
 		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
 	}
@@ -643,6 +670,9 @@ func testAccFreeIPASudoRuleUserMembership_resource(dataset map[string]string) st
 	if dataset["groups"] != "" {
 		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
 	}
+	if dataset["external_users"] != "" {
+		tf_def += fmt.Sprintf("  external_users = %s\n", dataset["external_users"])
+	}
 	if dataset["identifier"] != "" {
 		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
 	}
@@ -661,6 +691,9 @@ func testAccFreeIPASudoRuleRunAsGroupMembership_resource(dataset map[string]stri
 	if dataset["runasgroups"] != "" {
 		tf_def += fmt.Sprintf("  runasgroups = %s\n", dataset["runasgroups"])
 	}
+	if dataset["external_runasgroups"] != "" {
+		tf_def += fmt.Sprintf("  external_runasgroups = %s\n", dataset["external_runasgroups"])
+	}
 	if dataset["identifier"] != "" {
 		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
 	}
@@ -679,6 +712,9 @@ func testAccFreeIPASudoRuleRunAsUserMembership_resource(dataset map[string]strin
 	if dataset["runasusers"] != "" {
 		tf_def += fmt.Sprintf("  runasusers = %s\n", dataset["runasusers"])
 	}
+	if dataset["external_runasusers"] != "" {
+		tf_def += fmt.Sprintf("  external_runasusers = %s\n", dataset["external_runasusers"])
+	}
 	if dataset["identifier"] != "" {
 		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
 	}
@@ -789,3 +825,1342 @@ func testAccFreeIPAHbacPolicyServiceMembership_resource(dataset map[string]strin
 	tf_def += "}\n"
 	return tf_def
 }
+
+func testAccFreeIPAService_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_service" "service-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user_certificates"] != "" {
+		tf_def += fmt.Sprintf("  user_certificates = %s\n", dataset["user_certificates"])
+	}
+	if dataset["krb_auth_indicators"] != "" {
+		tf_def += fmt.Sprintf("  krb_auth_indicators = %s\n", dataset["krb_auth_indicators"])
+	}
+	if dataset["pac_type"] != "" {
+		tf_def += fmt.Sprintf("  pac_type = %s\n", dataset["pac_type"])
+	}
+	if dataset["krb_preauth"] != "" {
+		tf_def += fmt.Sprintf("  krb_preauth = %s\n", dataset["krb_preauth"])
+	}
+	if dataset["trusted_for_delegation"] != "" {
+		tf_def += fmt.Sprintf("  trusted_for_delegation = %s\n", dataset["trusted_for_delegation"])
+	}
+	if dataset["trusted_to_auth_as_delegate"] != "" {
+		tf_def += fmt.Sprintf("  trusted_to_auth_as_delegate = %s\n", dataset["trusted_to_auth_as_delegate"])
+	}
+	if dataset["force"] != "" {
+		tf_def += fmt.Sprintf("  force = %s\n", dataset["force"])
+	}
+	if dataset["skip_host_check"] != "" {
+		tf_def += fmt.Sprintf("  skip_host_check = %s\n", dataset["skip_host_check"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAService_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_service" "service-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAServiceManagedByMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_service_managedby_membership" "service-managedby-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAServiceRetrieveKeytabMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_service_retrieve_keytab_membership" "service-retrieve-keytab-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAServiceCreateKeytabMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_service_create_keytab_membership" "service-create-keytab-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHostManagedByMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_host_managedby_membership" "host-managedby-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHostRetrieveKeytabMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_host_retrieve_keytab_membership" "host-retrieve-keytab-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHostCreateKeytabMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_host_create_keytab_membership" "host-create-keytab-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPermission_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_permission" "permission-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["right"] != "" {
+		tf_def += fmt.Sprintf("  right = %s\n", dataset["right"])
+	}
+	if dataset["bind_type"] != "" {
+		tf_def += fmt.Sprintf("  bind_type = %s\n", dataset["bind_type"])
+	}
+	if dataset["subtree"] != "" {
+		tf_def += fmt.Sprintf("  subtree = %s\n", dataset["subtree"])
+	}
+	if dataset["attrs"] != "" {
+		tf_def += fmt.Sprintf("  attrs = %s\n", dataset["attrs"])
+	}
+	if dataset["extra_target_filter"] != "" {
+		tf_def += fmt.Sprintf("  extra_target_filter = %s\n", dataset["extra_target_filter"])
+	}
+	if dataset["raw_filter"] != "" {
+		tf_def += fmt.Sprintf("  raw_filter = %s\n", dataset["raw_filter"])
+	}
+	if dataset["memberof"] != "" {
+		tf_def += fmt.Sprintf("  memberof = %s\n", dataset["memberof"])
+	}
+	if dataset["target"] != "" {
+		tf_def += fmt.Sprintf("  target = %s\n", dataset["target"])
+	}
+	if dataset["target_to"] != "" {
+		tf_def += fmt.Sprintf("  target_to = %s\n", dataset["target_to"])
+	}
+	if dataset["target_from"] != "" {
+		tf_def += fmt.Sprintf("  target_from = %s\n", dataset["target_from"])
+	}
+	if dataset["target_group"] != "" {
+		tf_def += fmt.Sprintf("  target_group = %s\n", dataset["target_group"])
+	}
+	if dataset["type"] != "" {
+		tf_def += fmt.Sprintf("  type = %s\n", dataset["type"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPermission_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_permission" "permission-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAPrivilege_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_privilege" "privilege-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPrivilege_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_privilege" "privilege-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPARole_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_role" "role-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPARole_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_role" "role-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAPrivilegePermissionMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_privilege_permission_membership" "privilege-permission-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["permissions"] != "" {
+		tf_def += fmt.Sprintf("  permissions = %s\n", dataset["permissions"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPARolePrivilegeMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_role_privilege_membership" "role-privilege-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["privileges"] != "" {
+		tf_def += fmt.Sprintf("  privileges = %s\n", dataset["privileges"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPARoleMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_role_membership" "role-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["services"] != "" {
+		tf_def += fmt.Sprintf("  services = %s\n", dataset["services"])
+	}
+	if dataset["sysaccounts"] != "" {
+		tf_def += fmt.Sprintf("  sysaccounts = %s\n", dataset["sysaccounts"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPasswordPolicy_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_password_policy" "password-policy-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["priority"] != "" {
+		tf_def += fmt.Sprintf("  priority = %s\n", dataset["priority"])
+	}
+	if dataset["max_lifetime"] != "" {
+		tf_def += fmt.Sprintf("  max_lifetime = %s\n", dataset["max_lifetime"])
+	}
+	if dataset["min_lifetime"] != "" {
+		tf_def += fmt.Sprintf("  min_lifetime = %s\n", dataset["min_lifetime"])
+	}
+	if dataset["history_length"] != "" {
+		tf_def += fmt.Sprintf("  history_length = %s\n", dataset["history_length"])
+	}
+	if dataset["min_classes"] != "" {
+		tf_def += fmt.Sprintf("  min_classes = %s\n", dataset["min_classes"])
+	}
+	if dataset["min_length"] != "" {
+		tf_def += fmt.Sprintf("  min_length = %s\n", dataset["min_length"])
+	}
+	if dataset["max_failures"] != "" {
+		tf_def += fmt.Sprintf("  max_failures = %s\n", dataset["max_failures"])
+	}
+	if dataset["failure_interval"] != "" {
+		tf_def += fmt.Sprintf("  failure_interval = %s\n", dataset["failure_interval"])
+	}
+	if dataset["lockout_duration"] != "" {
+		tf_def += fmt.Sprintf("  lockout_duration = %s\n", dataset["lockout_duration"])
+	}
+	if dataset["max_repeat"] != "" {
+		tf_def += fmt.Sprintf("  max_repeat = %s\n", dataset["max_repeat"])
+	}
+	if dataset["max_sequence"] != "" {
+		tf_def += fmt.Sprintf("  max_sequence = %s\n", dataset["max_sequence"])
+	}
+	if dataset["dictionary_check"] != "" {
+		tf_def += fmt.Sprintf("  dictionary_check = %s\n", dataset["dictionary_check"])
+	}
+	if dataset["user_check"] != "" {
+		tf_def += fmt.Sprintf("  user_check = %s\n", dataset["user_check"])
+	}
+	if dataset["grace_login_limit"] != "" {
+		tf_def += fmt.Sprintf("  grace_login_limit = %s\n", dataset["grace_login_limit"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPasswordPolicy_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_password_policy" "password-policy-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAKerberosTicketPolicy_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_kerberos_ticket_policy" "kerberos-ticket-policy-%s" {
+	`, dataset["index"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["max_life"] != "" {
+		tf_def += fmt.Sprintf("  max_life = %s\n", dataset["max_life"])
+	}
+	if dataset["max_renew"] != "" {
+		tf_def += fmt.Sprintf("  max_renew = %s\n", dataset["max_renew"])
+	}
+	if dataset["otp_max_life"] != "" {
+		tf_def += fmt.Sprintf("  otp_max_life = %s\n", dataset["otp_max_life"])
+	}
+	if dataset["otp_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  otp_max_renew = %s\n", dataset["otp_max_renew"])
+	}
+	if dataset["radius_max_life"] != "" {
+		tf_def += fmt.Sprintf("  radius_max_life = %s\n", dataset["radius_max_life"])
+	}
+	if dataset["radius_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  radius_max_renew = %s\n", dataset["radius_max_renew"])
+	}
+	if dataset["pkinit_max_life"] != "" {
+		tf_def += fmt.Sprintf("  pkinit_max_life = %s\n", dataset["pkinit_max_life"])
+	}
+	if dataset["pkinit_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  pkinit_max_renew = %s\n", dataset["pkinit_max_renew"])
+	}
+	if dataset["hardened_max_life"] != "" {
+		tf_def += fmt.Sprintf("  hardened_max_life = %s\n", dataset["hardened_max_life"])
+	}
+	if dataset["hardened_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  hardened_max_renew = %s\n", dataset["hardened_max_renew"])
+	}
+	if dataset["idp_max_life"] != "" {
+		tf_def += fmt.Sprintf("  idp_max_life = %s\n", dataset["idp_max_life"])
+	}
+	if dataset["idp_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  idp_max_renew = %s\n", dataset["idp_max_renew"])
+	}
+	if dataset["passkey_max_life"] != "" {
+		tf_def += fmt.Sprintf("  passkey_max_life = %s\n", dataset["passkey_max_life"])
+	}
+	if dataset["passkey_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  passkey_max_renew = %s\n", dataset["passkey_max_renew"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHbacService_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_hbac_service" "hbac-service-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHbacService_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_hbac_service" "hbac-service-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAHbacServiceGroup_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_hbac_servicegroup" "hbac-servicegroup-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHbacServiceGroup_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_hbac_servicegroup" "hbac-servicegroup-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAHbacServiceGroupMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_hbac_servicegroup_membership" "hbac-servicegroup-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["services"] != "" {
+		tf_def += fmt.Sprintf("  services = %s\n", dataset["services"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHbacTest_datasource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	data "freeipa_hbac_test" "hbac-test-%s" {
+	  user        = %s
+	  targethost  = %s
+	  service     = %s
+	`, dataset["index"], dataset["user"], dataset["targethost"], dataset["service"])
+	if dataset["rules"] != "" {
+		tf_def += fmt.Sprintf("  rules = %s\n", dataset["rules"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPANetgroup_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_netgroup" "netgroup-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["nisdomain"] != "" {
+		tf_def += fmt.Sprintf("  nisdomain = %s\n", dataset["nisdomain"])
+	}
+	if dataset["usercategory"] != "" {
+		tf_def += fmt.Sprintf("  usercategory = %s\n", dataset["usercategory"])
+	}
+	if dataset["hostcategory"] != "" {
+		tf_def += fmt.Sprintf("  hostcategory = %s\n", dataset["hostcategory"])
+	}
+	if dataset["external_host"] != "" {
+		tf_def += fmt.Sprintf("  external_host = %s\n", dataset["external_host"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPANetgroupUserMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_netgroup_user_membership" "netgroup-user-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPANetgroupHostMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_netgroup_host_membership" "netgroup-host-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPANetgroupNetgroupMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_netgroup_netgroup_membership" "netgroup-netgroup-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["netgroups"] != "" {
+		tf_def += fmt.Sprintf("  netgroups = %s\n", dataset["netgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAAutomountLocation_resource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	resource "freeipa_automount_location" "automount-location-%s" {
+	  name        = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAAutomountLocation_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_automount_location" "automount-location-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAAutomountMap_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_automount_map" "automount-map-%s" {
+	  location    = %s
+	  name        = %s
+	`, dataset["index"], dataset["location"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["mount_point"] != "" {
+		tf_def += fmt.Sprintf("  mount_point = %s\n", dataset["mount_point"])
+	}
+	if dataset["parent_map"] != "" {
+		tf_def += fmt.Sprintf("  parent_map = %s\n", dataset["parent_map"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAAutomountKey_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_automount_key" "automount-key-%s" {
+	  location    = %s
+	  map         = %s
+	  key         = %s
+	  info        = %s
+	`, dataset["index"], dataset["location"], dataset["map"], dataset["key"], dataset["info"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdView_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idview" "idview-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["domain_resolution_order"] != "" {
+		tf_def += fmt.Sprintf("  domain_resolution_order = %s\n", dataset["domain_resolution_order"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdViewHostMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idview_host_membership" "idview-host-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdOverrideUser_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idoverride_user" "idoverride-user-%s" {
+	  idview      = %s
+	  anchor      = %s
+	`, dataset["index"], dataset["idview"], dataset["anchor"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["login"] != "" {
+		tf_def += fmt.Sprintf("  login = %s\n", dataset["login"])
+	}
+	if dataset["uid_number"] != "" {
+		tf_def += fmt.Sprintf("  uid_number = %s\n", dataset["uid_number"])
+	}
+	if dataset["gid_number"] != "" {
+		tf_def += fmt.Sprintf("  gid_number = %s\n", dataset["gid_number"])
+	}
+	if dataset["gecos"] != "" {
+		tf_def += fmt.Sprintf("  gecos = %s\n", dataset["gecos"])
+	}
+	if dataset["home_directory"] != "" {
+		tf_def += fmt.Sprintf("  home_directory = %s\n", dataset["home_directory"])
+	}
+	if dataset["login_shell"] != "" {
+		tf_def += fmt.Sprintf("  login_shell = %s\n", dataset["login_shell"])
+	}
+	if dataset["ssh_public_keys"] != "" {
+		tf_def += fmt.Sprintf("  ssh_public_keys = %s\n", dataset["ssh_public_keys"])
+	}
+	if dataset["user_certificates"] != "" {
+		tf_def += fmt.Sprintf("  user_certificates = %s\n", dataset["user_certificates"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdOverrideGroup_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idoverride_group" "idoverride-group-%s" {
+	  idview      = %s
+	  anchor      = %s
+	`, dataset["index"], dataset["idview"], dataset["anchor"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["name"] != "" {
+		tf_def += fmt.Sprintf("  name = %s\n", dataset["name"])
+	}
+	if dataset["gid_number"] != "" {
+		tf_def += fmt.Sprintf("  gid_number = %s\n", dataset["gid_number"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdRange_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idrange" "idrange-%s" {
+	  name    = %s
+	  base_id = %s
+	  size    = %s
+	`, dataset["index"], dataset["name"], dataset["base_id"], dataset["size"])
+	if dataset["rid_base"] != "" {
+		tf_def += fmt.Sprintf("  rid_base = %s\n", dataset["rid_base"])
+	}
+	if dataset["secondary_rid_base"] != "" {
+		tf_def += fmt.Sprintf("  secondary_rid_base = %s\n", dataset["secondary_rid_base"])
+	}
+	if dataset["range_type"] != "" {
+		tf_def += fmt.Sprintf("  range_type = %s\n", dataset["range_type"])
+	}
+	if dataset["auto_private_groups"] != "" {
+		tf_def += fmt.Sprintf("  auto_private_groups = %s\n", dataset["auto_private_groups"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdRanges_datasource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	data "freeipa_idranges" "idranges-%s" {
+	`, dataset["index"])
+	if dataset["range_type"] != "" {
+		tf_def += fmt.Sprintf("  range_type = %s\n", dataset["range_type"])
+	}
+	if dataset["depends_on"] != "" {
+		tf_def += fmt.Sprintf("  depends_on = %s\n", dataset["depends_on"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPATrust_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_trust" "trust-%s" {
+	  realm = %s
+	`, dataset["index"], dataset["realm"])
+	if dataset["direction"] != "" {
+		tf_def += fmt.Sprintf("  direction = %s\n", dataset["direction"])
+	}
+	if dataset["admin"] != "" {
+		tf_def += fmt.Sprintf("  admin = %s\n", dataset["admin"])
+	}
+	if dataset["admin_password_wo"] != "" {
+		tf_def += fmt.Sprintf("  admin_password_wo = %s\n", dataset["admin_password_wo"])
+	}
+	if dataset["shared_secret_wo"] != "" {
+		tf_def += fmt.Sprintf("  shared_secret_wo = %s\n", dataset["shared_secret_wo"])
+	}
+	if dataset["shared_secret_wo_version"] != "" {
+		tf_def += fmt.Sprintf("  shared_secret_wo_version = %s\n", dataset["shared_secret_wo_version"])
+	}
+	if dataset["range_type"] != "" {
+		tf_def += fmt.Sprintf("  range_type = %s\n", dataset["range_type"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACertificate_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_certificate" "certificate-%s" {
+	  principal = %s
+	  csr       = %s
+	`, dataset["index"], dataset["principal"], dataset["csr"])
+	if dataset["profile"] != "" {
+		tf_def += fmt.Sprintf("  profile = %s\n", dataset["profile"])
+	}
+	if dataset["ca"] != "" {
+		tf_def += fmt.Sprintf("  ca = %s\n", dataset["ca"])
+	}
+	if dataset["revocation_reason"] != "" {
+		tf_def += fmt.Sprintf("  revocation_reason = %s\n", dataset["revocation_reason"])
+	}
+	if dataset["early_renewal_hours"] != "" {
+		tf_def += fmt.Sprintf("  early_renewal_hours = %s\n", dataset["early_renewal_hours"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACertificateProfile_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_certificate_profile" "certprofile-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["store"] != "" {
+		tf_def += fmt.Sprintf("  store = %s\n", dataset["store"])
+	}
+	if dataset["config"] != "" {
+		tf_def += fmt.Sprintf("  config = %s\n", dataset["config"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaacl_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl" "caacl-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["enabled"] != "" {
+		tf_def += fmt.Sprintf("  enabled = %s\n", dataset["enabled"])
+	}
+	if dataset["usercategory"] != "" {
+		tf_def += fmt.Sprintf("  usercategory = %s\n", dataset["usercategory"])
+	}
+	if dataset["hostcategory"] != "" {
+		tf_def += fmt.Sprintf("  hostcategory = %s\n", dataset["hostcategory"])
+	}
+	if dataset["servicecategory"] != "" {
+		tf_def += fmt.Sprintf("  servicecategory = %s\n", dataset["servicecategory"])
+	}
+	if dataset["profilecategory"] != "" {
+		tf_def += fmt.Sprintf("  profilecategory = %s\n", dataset["profilecategory"])
+	}
+	if dataset["cacategory"] != "" {
+		tf_def += fmt.Sprintf("  cacategory = %s\n", dataset["cacategory"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclUserMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_user_membership" "caacl-user-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclHostMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_host_membership" "caacl-host-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclServiceMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_service_membership" "caacl-service-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["services"] != "" {
+		tf_def += fmt.Sprintf("  services = %s\n", dataset["services"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclProfileMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_profile_membership" "caacl-profile-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["profiles"] != "" {
+		tf_def += fmt.Sprintf("  profiles = %s\n", dataset["profiles"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclCaMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_ca_membership" "caacl-ca-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["cas"] != "" {
+		tf_def += fmt.Sprintf("  cas = %s\n", dataset["cas"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACa_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_ca" "ca-%s" {
+	  name        = %s
+	  subject_dn  = %s
+	`, dataset["index"], dataset["name"], dataset["subject_dn"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["enabled"] != "" {
+		tf_def += fmt.Sprintf("  enabled = %s\n", dataset["enabled"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACa_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_ca" "ca-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPACertmapRule_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_certmap_rule" "certmap-rule-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["map_rule"] != "" {
+		tf_def += fmt.Sprintf("  map_rule = %s\n", dataset["map_rule"])
+	}
+	if dataset["match_rule"] != "" {
+		tf_def += fmt.Sprintf("  match_rule = %s\n", dataset["match_rule"])
+	}
+	if dataset["domains"] != "" {
+		tf_def += fmt.Sprintf("  domains = %s\n", dataset["domains"])
+	}
+	if dataset["priority"] != "" {
+		tf_def += fmt.Sprintf("  priority = %s\n", dataset["priority"])
+	}
+	if dataset["enabled"] != "" {
+		tf_def += fmt.Sprintf("  enabled = %s\n", dataset["enabled"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACertmapConfig_resource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	resource "freeipa_certmap_config" "certmap-config" {
+	  prompt_username = %s
+	}
+	`, dataset["prompt_username"])
+}
+
+func testAccFreeIPAUserCertmapdata_resource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	resource "freeipa_user_certmapdata" "user-certmapdata-%s" {
+	  user    = %s
+	  issuer  = %s
+	  subject = %s
+	}
+	`, dataset["index"], dataset["user"], dataset["issuer"], dataset["subject"])
+}
+
+func testAccFreeIPASelinuxUsermap_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_selinux_usermap" "selinux-usermap-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["selinux_user"] != "" {
+		tf_def += fmt.Sprintf("  selinux_user = %s\n", dataset["selinux_user"])
+	}
+	if dataset["hbac_rule"] != "" {
+		tf_def += fmt.Sprintf("  hbac_rule = %s\n", dataset["hbac_rule"])
+	}
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["enabled"] != "" {
+		tf_def += fmt.Sprintf("  enabled = %s\n", dataset["enabled"])
+	}
+	if dataset["usercategory"] != "" {
+		tf_def += fmt.Sprintf("  usercategory = %s\n", dataset["usercategory"])
+	}
+	if dataset["hostcategory"] != "" {
+		tf_def += fmt.Sprintf("  hostcategory = %s\n", dataset["hostcategory"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPASelinuxUsermapUserMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_selinux_usermap_user_membership" "selinux-usermap-user-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPASelinuxUsermapHostMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_selinux_usermap_host_membership" "selinux-usermap-host-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAOtpToken_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_otp_token" "otp-token-%s" {
+	`, dataset["index"])
+	if dataset["unique_id"] != "" {
+		tf_def += fmt.Sprintf("  unique_id = %s\n", dataset["unique_id"])
+	}
+	if dataset["owner"] != "" {
+		tf_def += fmt.Sprintf("  owner = %s\n", dataset["owner"])
+	}
+	if dataset["type"] != "" {
+		tf_def += fmt.Sprintf("  type = %s\n", dataset["type"])
+	}
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["algorithm"] != "" {
+		tf_def += fmt.Sprintf("  algorithm = %s\n", dataset["algorithm"])
+	}
+	if dataset["digits"] != "" {
+		tf_def += fmt.Sprintf("  digits = %s\n", dataset["digits"])
+	}
+	if dataset["interval"] != "" {
+		tf_def += fmt.Sprintf("  interval = %s\n", dataset["interval"])
+	}
+	if dataset["counter"] != "" {
+		tf_def += fmt.Sprintf("  counter = %s\n", dataset["counter"])
+	}
+	if dataset["not_before"] != "" {
+		tf_def += fmt.Sprintf("  not_before = %s\n", dataset["not_before"])
+	}
+	if dataset["not_after"] != "" {
+		tf_def += fmt.Sprintf("  not_after = %s\n", dataset["not_after"])
+	}
+	if dataset["disabled"] != "" {
+		tf_def += fmt.Sprintf("  disabled = %s\n", dataset["disabled"])
+	}
+	if dataset["managed_by"] != "" {
+		tf_def += fmt.Sprintf("  managed_by = %s\n", dataset["managed_by"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPARadiusProxy_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_radius_proxy" "radius-proxy-%s" {
+	  name      = %s
+	  servers   = %s
+	  secret_wo = %s
+	`, dataset["index"], dataset["name"], dataset["servers"], dataset["secret_wo"])
+	if dataset["secret_wo_version"] != "" {
+		tf_def += fmt.Sprintf("  secret_wo_version = %s\n", dataset["secret_wo_version"])
+	}
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["timeout"] != "" {
+		tf_def += fmt.Sprintf("  timeout = %s\n", dataset["timeout"])
+	}
+	if dataset["retries"] != "" {
+		tf_def += fmt.Sprintf("  retries = %s\n", dataset["retries"])
+	}
+	if dataset["user_attribute"] != "" {
+		tf_def += fmt.Sprintf("  user_attribute = %s\n", dataset["user_attribute"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdp_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idp" "idp-%s" {
+	  name      = %s
+	  client_id = %s
+	`, dataset["index"], dataset["name"], dataset["client_id"])
+	if dataset["provider_template"] != "" {
+		tf_def += fmt.Sprintf("  provider_template = %s\n", dataset["provider_template"])
+	}
+	if dataset["organization"] != "" {
+		tf_def += fmt.Sprintf("  organization = %s\n", dataset["organization"])
+	}
+	if dataset["base_url"] != "" {
+		tf_def += fmt.Sprintf("  base_url = %s\n", dataset["base_url"])
+	}
+	if dataset["client_secret_wo"] != "" {
+		tf_def += fmt.Sprintf("  client_secret_wo = %s\n", dataset["client_secret_wo"])
+	}
+	if dataset["client_secret_wo_version"] != "" {
+		tf_def += fmt.Sprintf("  client_secret_wo_version = %s\n", dataset["client_secret_wo_version"])
+	}
+	if dataset["auth_uri"] != "" {
+		tf_def += fmt.Sprintf("  auth_uri = %s\n", dataset["auth_uri"])
+	}
+	if dataset["dev_auth_uri"] != "" {
+		tf_def += fmt.Sprintf("  dev_auth_uri = %s\n", dataset["dev_auth_uri"])
+	}
+	if dataset["token_uri"] != "" {
+		tf_def += fmt.Sprintf("  token_uri = %s\n", dataset["token_uri"])
+	}
+	if dataset["userinfo_uri"] != "" {
+		tf_def += fmt.Sprintf("  userinfo_uri = %s\n", dataset["userinfo_uri"])
+	}
+	if dataset["keys_uri"] != "" {
+		tf_def += fmt.Sprintf("  keys_uri = %s\n", dataset["keys_uri"])
+	}
+	if dataset["scope"] != "" {
+		tf_def += fmt.Sprintf("  scope = %s\n", dataset["scope"])
+	}
+	if dataset["idp_user_id"] != "" {
+		tf_def += fmt.Sprintf("  idp_user_id = %s\n", dataset["idp_user_id"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAVault_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_vault" "vault-%s" {
+	  name = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["type"] != "" {
+		tf_def += fmt.Sprintf("  type = %s\n", dataset["type"])
+	}
+	if dataset["username"] != "" {
+		tf_def += fmt.Sprintf("  username = %s\n", dataset["username"])
+	}
+	if dataset["service"] != "" {
+		tf_def += fmt.Sprintf("  service = %s\n", dataset["service"])
+	}
+	if dataset["shared"] != "" {
+		tf_def += fmt.Sprintf("  shared = %s\n", dataset["shared"])
+	}
+	if dataset["public_key"] != "" {
+		tf_def += fmt.Sprintf("  public_key = %s\n", dataset["public_key"])
+	}
+	if dataset["password_wo"] != "" {
+		tf_def += fmt.Sprintf("  password_wo = %s\n", dataset["password_wo"])
+	}
+	if dataset["data_wo"] != "" {
+		tf_def += fmt.Sprintf("  data_wo = %s\n", dataset["data_wo"])
+	}
+	if dataset["data_wo_version"] != "" {
+		tf_def += fmt.Sprintf("  data_wo_version = %s\n", dataset["data_wo_version"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAVaultMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_vault_membership" "vault-membership-%s" {
+	  name       = %s
+	  identifier = %s
+	`, dataset["index"], dataset["name"], dataset["identifier"])
+	if dataset["username"] != "" {
+		tf_def += fmt.Sprintf("  username = %s\n", dataset["username"])
+	}
+	if dataset["service"] != "" {
+		tf_def += fmt.Sprintf("  service = %s\n", dataset["service"])
+	}
+	if dataset["shared"] != "" {
+		tf_def += fmt.Sprintf("  shared = %s\n", dataset["shared"])
+	}
+	if dataset["owner_users"] != "" {
+		tf_def += fmt.Sprintf("  owner_users = %s\n", dataset["owner_users"])
+	}
+	if dataset["owner_groups"] != "" {
+		tf_def += fmt.Sprintf("  owner_groups = %s\n", dataset["owner_groups"])
+	}
+	if dataset["owner_services"] != "" {
+		tf_def += fmt.Sprintf("  owner_services = %s\n", dataset["owner_services"])
+	}
+	if dataset["member_users"] != "" {
+		tf_def += fmt.Sprintf("  member_users = %s\n", dataset["member_users"])
+	}
+	if dataset["member_groups"] != "" {
+		tf_def += fmt.Sprintf("  member_groups = %s\n", dataset["member_groups"])
+	}
+	if dataset["member_services"] != "" {
+		tf_def += fmt.Sprintf("  member_services = %s\n", dataset["member_services"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPASysaccount_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_sysaccount" "sysaccount-%s" {
+	  name        = %s
+	  password_wo = %s
+	`, dataset["index"], dataset["name"], dataset["password_wo"])
+	if dataset["password_wo_version"] != "" {
+		tf_def += fmt.Sprintf("  password_wo_version = %s\n", dataset["password_wo_version"])
+	}
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["privileged"] != "" {
+		tf_def += fmt.Sprintf("  privileged = %s\n", dataset["privileged"])
+	}
+	if dataset["disabled"] != "" {
+		tf_def += fmt.Sprintf("  disabled = %s\n", dataset["disabled"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPASubid_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_subid" "subid-%s" {
+	  owner = %s
+	`, dataset["index"], dataset["owner"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
@@ -0,0 +1,441 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HostManagedByMembershipResource{}
+var _ resource.ResourceWithImportState = &HostManagedByMembershipResource{}
+var _ resource.ResourceWithConfigValidators = &HostManagedByMembershipResource{}
+
+func NewHostManagedByMembershipResource() resource.Resource {
+	return &HostManagedByMembershipResource{}
+}
+
+// HostManagedByMembershipResource defines the resource implementation.
+type HostManagedByMembershipResource struct {
+	client *ipa.Client
+}
+
+// HostManagedByMembershipResourceModel describes the resource data model.
+type HostManagedByMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Host       types.String `tfsdk:"host"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *HostManagedByMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_managedby_membership"
+}
+
+func (r *HostManagedByMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("identifier"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("host"),
+			path.MatchRoot("hosts"),
+		),
+	}
+}
+
+func (r *HostManagedByMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Host managed by membership resource.\nHosts added by this resource are allowed to manage the host (e.g. retrieve its keytab and certificates).\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Host fully qualified name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host allowed to manage the host. Cannot be used together with `identifier`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "List of hosts allowed to manage the host",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple host managed by membership resources on the same host. Required when using `hosts`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *HostManagedByMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HostManagedByMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HostManagedByMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.HostAddManagedbyOptionalArgs{}
+
+	args := ipa.HostAddManagedbyArgs{
+		Fqdn: data.Name.ValueString(),
+	}
+	if !data.Hosts.IsNull() {
+		var v []string
+		for _, value := range data.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Host = &v
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+	}
+
+	_v, err := r.client.HostAddManagedby(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa host managed by membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa host managed by membership: %v", _v.Failed))
+	}
+
+	switch {
+	case !data.Host.IsNull():
+		data.Id = types.StringValue(fmt.Sprintf("%s/h/%s", encodeSlash(data.Name.ValueString()), data.Host.ValueString()))
+	default:
+		data.Id = types.StringValue(fmt.Sprintf("%s/mu/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString()))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostManagedByMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HostManagedByMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostId, typeId, memberId, err := parseHostMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_host_managedby_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.HostShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.HostShowArgs{
+		Fqdn: hostId,
+	}
+
+	res, err := r.client.HostShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Host not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa host: %s", err))
+			return
+		}
+	}
+
+	if res.Result.ManagedbyHost == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	switch typeId {
+	case "h":
+		if res.Result.ManagedbyHost == nil || !isStringListContainsCaseInsensistive(res.Result.ManagedbyHost, &memberId) {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host managedby host member %s is not present in results", memberId))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Host = types.StringValue(memberId)
+	}
+	if !data.Hosts.IsNull() {
+		var changedVals []string
+		for _, value := range data.Hosts.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host managed by host failed with error %s", err))
+			}
+			if isStringListContainsCaseInsensistive(res.Result.ManagedbyHost, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host managed by host %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.Hosts, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *HostManagedByMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HostManagedByMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.HostAddManagedbyOptionalArgs{}
+
+	memberAddArgs := ipa.HostAddManagedbyArgs{
+		Fqdn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.HostRemoveManagedbyOptionalArgs{}
+
+	memberDelArgs := ipa.HostRemoveManagedbyArgs{
+		Fqdn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Hosts.Equal(state.Hosts) {
+		var statearr, planarr, addedHosts, deletedHosts []string
+
+		for _, value := range state.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedHosts = append(addedHosts, val)
+				memberAddOptArgs.Host = &addedHosts
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedHosts = append(deletedHosts, value)
+				memberDelOptArgs.Host = &deletedHosts
+				hasMemberDel = true
+			}
+		}
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.HostAddManagedby(&memberAddArgs, &memberAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa host managed by membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Create freeipa host managed by membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa host managed by membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.HostRemoveManagedby(&memberDelArgs, &memberDelOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa host managed by membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Remove freeipa host managed by membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa host managed by membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostManagedByMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HostManagedByMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hostId, _, _, err := parseHostMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_host_managedby_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.HostRemoveManagedbyOptionalArgs{}
+
+	args := ipa.HostRemoveManagedbyArgs{
+		Fqdn: hostId,
+	}
+
+	if !data.Hosts.IsNull() {
+		var v []string
+		for _, value := range data.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Host = &v
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+	}
+
+	_, err = r.client.HostRemoveManagedby(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa host managed by membership: %s", err))
+		return
+	}
+}
+
+func (r *HostManagedByMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostId, typeId, memberId, err := parseHostMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+	if typeId != "h" && typeId != "mu" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("The ID %q must use the type 'h' with a member, or the type 'mu' with an identifier.", req.ID))
+		return
+	}
+
+	all := true
+	optArgs := ipa.HostShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.HostShowArgs{
+		Fqdn: hostId,
+	}
+
+	res, err := r.client.HostShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Host not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa host: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), hostId)...)
+	switch typeId {
+	case "h":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), memberId)...)
+	case "mu":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		// The host itself is implicitly part of the managed by hosts, it is not imported
+		var hosts []string
+		if res.Result.ManagedbyHost != nil {
+			for _, host := range *res.Result.ManagedbyHost {
+				if !strings.EqualFold(host, hostId) {
+					hosts = append(hosts, host)
+				}
+			}
+		}
+		if len(hosts) > 0 {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hosts"), hosts)...)
+		}
+	}
+}
+
+func parseHostMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine host membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	identifier := idParts[2]
+
+	return name, _type, identifier, nil
+}
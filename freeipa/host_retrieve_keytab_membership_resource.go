@@ -0,0 +1,717 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HostRetrieveKeytabMembershipResource{}
+var _ resource.ResourceWithImportState = &HostRetrieveKeytabMembershipResource{}
+
+func NewHostRetrieveKeytabMembershipResource() resource.Resource {
+	return &HostRetrieveKeytabMembershipResource{}
+}
+
+// HostRetrieveKeytabMembershipResource defines the resource implementation.
+type HostRetrieveKeytabMembershipResource struct {
+	client *ipa.Client
+}
+
+// HostRetrieveKeytabMembershipResourceModel describes the resource data model.
+type HostRetrieveKeytabMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	User       types.String `tfsdk:"user"`
+	Group      types.String `tfsdk:"group"`
+	Host       types.String `tfsdk:"host"`
+	HostGroup  types.String `tfsdk:"hostgroup"`
+	Users      types.List   `tfsdk:"users"`
+	Groups     types.List   `tfsdk:"groups"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	HostGroups types.List   `tfsdk:"hostgroups"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *HostRetrieveKeytabMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_retrieve_keytab_membership"
+}
+
+func (r *HostRetrieveKeytabMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("identifier"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("users"),
+			path.MatchRoot("groups"),
+			path.MatchRoot("hosts"),
+			path.MatchRoot("hostgroups"),
+		),
+	}
+}
+
+func (r *HostRetrieveKeytabMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Host allowed to retrieve keytab membership resource.\nMembers added by this resource are allowed to retrieve the keytab of the host.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Host fully qualified name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User allowed to retrieve the keytab. Cannot be used together with `identifier`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "User group allowed to retrieve the keytab. Cannot be used together with `identifier`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host allowed to retrieve the keytab. Cannot be used together with `identifier`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostgroup": schema.StringAttribute{
+				MarkdownDescription: "Host group allowed to retrieve the keytab. Cannot be used together with `identifier`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.ListAttribute{
+				MarkdownDescription: "List of users allowed to retrieve the keytab",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "List of user groups allowed to retrieve the keytab",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "List of hosts allowed to retrieve the keytab",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"hostgroups": schema.ListAttribute{
+				MarkdownDescription: "List of host groups allowed to retrieve the keytab",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple host retrieve keytab membership resources on the same host. Required when using `users`, `groups`, `hosts` or `hostgroups`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *HostRetrieveKeytabMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HostRetrieveKeytabMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HostRetrieveKeytabMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.HostAllowRetrieveKeytabOptionalArgs{}
+
+	args := ipa.HostAllowRetrieveKeytabArgs{
+		Fqdn: data.Name.ValueString(),
+	}
+	if !data.Users.IsNull() {
+		var v []string
+		for _, value := range data.Users.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.User = &v
+	}
+	if !data.Groups.IsNull() {
+		var v []string
+		for _, value := range data.Groups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Group = &v
+	}
+	if !data.Hosts.IsNull() {
+		var v []string
+		for _, value := range data.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Host = &v
+	}
+	if !data.HostGroups.IsNull() {
+		var v []string
+		for _, value := range data.HostGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Hostgroup = &v
+	}
+	if !data.User.IsNull() {
+		v := []string{data.User.ValueString()}
+		optArgs.User = &v
+	}
+	if !data.Group.IsNull() {
+		v := []string{data.Group.ValueString()}
+		optArgs.Group = &v
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+	}
+	if !data.HostGroup.IsNull() {
+		v := []string{data.HostGroup.ValueString()}
+		optArgs.Hostgroup = &v
+	}
+
+	_v, err := r.client.HostAllowRetrieveKeytab(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa host retrieve keytab membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa host retrieve keytab membership: %v", _v.Failed))
+	}
+
+	switch {
+	case !data.User.IsNull():
+		data.Id = types.StringValue(fmt.Sprintf("%s/u/%s", encodeSlash(data.Name.ValueString()), data.User.ValueString()))
+	case !data.Group.IsNull():
+		data.Id = types.StringValue(fmt.Sprintf("%s/g/%s", encodeSlash(data.Name.ValueString()), data.Group.ValueString()))
+	case !data.Host.IsNull():
+		data.Id = types.StringValue(fmt.Sprintf("%s/h/%s", encodeSlash(data.Name.ValueString()), data.Host.ValueString()))
+	case !data.HostGroup.IsNull():
+		data.Id = types.StringValue(fmt.Sprintf("%s/hg/%s", encodeSlash(data.Name.ValueString()), data.HostGroup.ValueString()))
+	default:
+		data.Id = types.StringValue(fmt.Sprintf("%s/mu/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString()))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostRetrieveKeytabMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HostRetrieveKeytabMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostId, typeId, memberId, err := parseHostMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_host_retrieve_keytab_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.HostShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.HostShowArgs{
+		Fqdn: hostId,
+	}
+
+	res, err := r.client.HostShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Host not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa host: %s", err))
+			return
+		}
+	}
+
+	if res.Result.IpaallowedtoperformReadKeysUser == nil && res.Result.IpaallowedtoperformReadKeysGroup == nil && res.Result.IpaallowedtoperformReadKeysHost == nil && res.Result.IpaallowedtoperformReadKeysHostgroup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	switch typeId {
+	case "u":
+		if res.Result.IpaallowedtoperformReadKeysUser == nil || !isStringListContainsCaseInsensistive(res.Result.IpaallowedtoperformReadKeysUser, &memberId) {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab user member %s is not present in results", memberId))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.User = types.StringValue(memberId)
+	case "g":
+		if res.Result.IpaallowedtoperformReadKeysGroup == nil || !isStringListContainsCaseInsensistive(res.Result.IpaallowedtoperformReadKeysGroup, &memberId) {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab group member %s is not present in results", memberId))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Group = types.StringValue(memberId)
+	case "h":
+		if res.Result.IpaallowedtoperformReadKeysHost == nil || !isStringListContainsCaseInsensistive(res.Result.IpaallowedtoperformReadKeysHost, &memberId) {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab host member %s is not present in results", memberId))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Host = types.StringValue(memberId)
+	case "hg":
+		if res.Result.IpaallowedtoperformReadKeysHostgroup == nil || !isStringListContainsCaseInsensistive(res.Result.IpaallowedtoperformReadKeysHostgroup, &memberId) {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab hostgroup member %s is not present in results", memberId))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.HostGroup = types.StringValue(memberId)
+	}
+	if !data.Users.IsNull() {
+		var changedVals []string
+		for _, value := range data.Users.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab user member failed with error %s", err))
+			}
+			if res.Result.IpaallowedtoperformReadKeysUser != nil && isStringListContainsCaseInsensistive(res.Result.IpaallowedtoperformReadKeysUser, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab user member %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.Users, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.Groups.IsNull() {
+		var changedVals []string
+		for _, value := range data.Groups.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab user group member failed with error %s", err))
+			}
+			if res.Result.IpaallowedtoperformReadKeysGroup != nil && isStringListContainsCaseInsensistive(res.Result.IpaallowedtoperformReadKeysGroup, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab user group member %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.Groups, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.Hosts.IsNull() {
+		var changedVals []string
+		for _, value := range data.Hosts.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab host member failed with error %s", err))
+			}
+			if res.Result.IpaallowedtoperformReadKeysHost != nil && isStringListContainsCaseInsensistive(res.Result.IpaallowedtoperformReadKeysHost, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab host member %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.Hosts, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.HostGroups.IsNull() {
+		var changedVals []string
+		for _, value := range data.HostGroups.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab host group member failed with error %s", err))
+			}
+			if res.Result.IpaallowedtoperformReadKeysHostgroup != nil && isStringListContainsCaseInsensistive(res.Result.IpaallowedtoperformReadKeysHostgroup, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa host retrieve keytab host group member %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.HostGroups, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *HostRetrieveKeytabMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HostRetrieveKeytabMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.HostAllowRetrieveKeytabOptionalArgs{}
+
+	memberAddArgs := ipa.HostAllowRetrieveKeytabArgs{
+		Fqdn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.HostDisallowRetrieveKeytabOptionalArgs{}
+
+	memberDelArgs := ipa.HostDisallowRetrieveKeytabArgs{
+		Fqdn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Users.Equal(state.Users) {
+		var statearr, planarr, addedUsers, deletedUsers []string
+
+		for _, value := range state.Users.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.Users.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedUsers = append(addedUsers, val)
+				memberAddOptArgs.User = &addedUsers
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedUsers = append(deletedUsers, value)
+				memberDelOptArgs.User = &deletedUsers
+				hasMemberDel = true
+			}
+		}
+	}
+	if !data.Groups.Equal(state.Groups) {
+		var statearr, planarr, addedGroups, deletedGroups []string
+
+		for _, value := range state.Groups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.Groups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedGroups = append(addedGroups, val)
+				memberAddOptArgs.Group = &addedGroups
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedGroups = append(deletedGroups, value)
+				memberDelOptArgs.Group = &deletedGroups
+				hasMemberDel = true
+			}
+		}
+	}
+	if !data.Hosts.Equal(state.Hosts) {
+		var statearr, planarr, addedHosts, deletedHosts []string
+
+		for _, value := range state.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedHosts = append(addedHosts, val)
+				memberAddOptArgs.Host = &addedHosts
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedHosts = append(deletedHosts, value)
+				memberDelOptArgs.Host = &deletedHosts
+				hasMemberDel = true
+			}
+		}
+	}
+	if !data.HostGroups.Equal(state.HostGroups) {
+		var statearr, planarr, addedHostGroups, deletedHostGroups []string
+
+		for _, value := range state.HostGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.HostGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedHostGroups = append(addedHostGroups, val)
+				memberAddOptArgs.Hostgroup = &addedHostGroups
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedHostGroups = append(deletedHostGroups, value)
+				memberDelOptArgs.Hostgroup = &deletedHostGroups
+				hasMemberDel = true
+			}
+		}
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.HostAllowRetrieveKeytab(&memberAddArgs, &memberAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa host retrieve keytab membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Create freeipa host retrieve keytab membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa host retrieve keytab membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.HostDisallowRetrieveKeytab(&memberDelArgs, &memberDelOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa host retrieve keytab membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Remove freeipa host retrieve keytab membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa host retrieve keytab membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostRetrieveKeytabMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HostRetrieveKeytabMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hostId, _, _, err := parseHostMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_host_retrieve_keytab_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.HostDisallowRetrieveKeytabOptionalArgs{}
+
+	args := ipa.HostDisallowRetrieveKeytabArgs{
+		Fqdn: hostId,
+	}
+	if !data.Users.IsNull() {
+		var v []string
+		for _, value := range data.Users.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.User = &v
+	}
+	if !data.Groups.IsNull() {
+		var v []string
+		for _, value := range data.Groups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Group = &v
+	}
+	if !data.Hosts.IsNull() {
+		var v []string
+		for _, value := range data.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Host = &v
+	}
+	if !data.HostGroups.IsNull() {
+		var v []string
+		for _, value := range data.HostGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Hostgroup = &v
+	}
+	if !data.User.IsNull() {
+		v := []string{data.User.ValueString()}
+		optArgs.User = &v
+	}
+	if !data.Group.IsNull() {
+		v := []string{data.Group.ValueString()}
+		optArgs.Group = &v
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+	}
+	if !data.HostGroup.IsNull() {
+		v := []string{data.HostGroup.ValueString()}
+		optArgs.Hostgroup = &v
+	}
+
+	_, err = r.client.HostDisallowRetrieveKeytab(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa host retrieve keytab membership: %s", err))
+		return
+	}
+}
+
+func (r *HostRetrieveKeytabMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostId, typeId, memberId, err := parseHostMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+	if typeId != "u" && typeId != "g" && typeId != "h" && typeId != "hg" && typeId != "mu" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("The ID %q must use the type 'u', 'g', 'h', 'hg' with a member, or the type 'mu' with an identifier.", req.ID))
+		return
+	}
+
+	all := true
+	optArgs := ipa.HostShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.HostShowArgs{
+		Fqdn: hostId,
+	}
+
+	res, err := r.client.HostShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Host not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa host: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), hostId)...)
+	switch typeId {
+	case "u":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), memberId)...)
+	case "g":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), memberId)...)
+	case "h":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), memberId)...)
+	case "hg":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroup"), memberId)...)
+	case "mu":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		if res.Result.IpaallowedtoperformReadKeysUser != nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), res.Result.IpaallowedtoperformReadKeysUser)...)
+		}
+		if res.Result.IpaallowedtoperformReadKeysGroup != nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("groups"), res.Result.IpaallowedtoperformReadKeysGroup)...)
+		}
+		if res.Result.IpaallowedtoperformReadKeysHost != nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hosts"), res.Result.IpaallowedtoperformReadKeysHost)...)
+		}
+		if res.Result.IpaallowedtoperformReadKeysHostgroup != nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroups"), res.Result.IpaallowedtoperformReadKeysHostgroup)...)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdOverrideGroupResource{}
+var _ resource.ResourceWithImportState = &IdOverrideGroupResource{}
+
+func NewIdOverrideGroupResource() resource.Resource {
+	return &IdOverrideGroupResource{}
+}
+
+// IdOverrideGroupResource defines the resource implementation.
+type IdOverrideGroupResource struct {
+	client *ipa.Client
+}
+
+// IdOverrideGroupResourceModel describes the resource data model.
+type IdOverrideGroupResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	IdView      types.String `tfsdk:"idview"`
+	Anchor      types.String `tfsdk:"anchor"`
+	Description types.String `tfsdk:"description"`
+	Name        types.String `tfsdk:"name"`
+	GidNumber   types.Int64  `tfsdk:"gid_number"`
+}
+
+func (r *IdOverrideGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idoverride_group"
+}
+
+func (r *IdOverrideGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA group ID override resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idview": schema.StringAttribute{
+				MarkdownDescription: "Name of the ID view the override belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"anchor": schema.StringAttribute{
+				MarkdownDescription: "Name of the group to override (IPA group or trusted domain group)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "ID override description",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Overridden group name",
+				Optional:            true,
+			},
+			"gid_number": schema.Int64Attribute{
+				MarkdownDescription: "Overridden group ID number",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					idOverrideNumberRequiresReplaceIfRemoved(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IdOverrideGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdOverrideGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdOverrideGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.IdoverridegroupAddOptionalArgs{}
+
+	args := ipa.IdoverridegroupAddArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.Name.IsNull() {
+		optArgs.Cn = data.Name.ValueStringPointer()
+	}
+	if !data.GidNumber.IsNull() {
+		gid := int(data.GidNumber.ValueInt64())
+		optArgs.Gidnumber = &gid
+	}
+
+	_, err := r.client.IdoverridegroupAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa group id override: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", encodeSlash(data.IdView.ValueString()), encodeSlash(data.Anchor.ValueString())))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdOverrideGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdOverrideGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idview, anchor, err := parseIdOverrideID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_idoverride_group: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.IdoverridegroupShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.IdoverridegroupShowArgs{
+		Idviewcn:      idview,
+		Ipaanchoruuid: anchor,
+	}
+
+	res, err := r.client.IdoverridegroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Group id override not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa group id override: %s", err))
+			return
+		}
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Cn != nil && !data.Name.IsNull() {
+		data.Name = types.StringValue(*res.Result.Cn)
+	}
+	if res.Result.Gidnumber != nil && !data.GidNumber.IsNull() {
+		data.GidNumber = types.Int64Value(int64(*res.Result.Gidnumber))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdOverrideGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IdOverrideGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdoverridegroupModArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	optArgs := ipa.IdoverridegroupModOptionalArgs{}
+
+	var hasChange = false
+	empty := ""
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = &empty
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Name.Equal(state.Name) {
+		optArgs.Cn = &empty
+		if !data.Name.IsNull() {
+			optArgs.Cn = data.Name.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.GidNumber.Equal(state.GidNumber) && !data.GidNumber.IsNull() {
+		gid := int(data.GidNumber.ValueInt64())
+		optArgs.Gidnumber = &gid
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.IdoverridegroupMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa group id override: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = state.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdOverrideGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdOverrideGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idview, anchor, err := parseIdOverrideID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_idoverride_group: %s", err))
+		return
+	}
+
+	args := ipa.IdoverridegroupDelArgs{
+		Idviewcn:      idview,
+		Ipaanchoruuid: []string{anchor},
+	}
+	_, err = r.client.IdoverridegroupDel(&args, &ipa.IdoverridegroupDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa group id override: %s", err))
+		return
+	}
+}
+
+func (r *IdOverrideGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idview, anchor, err := parseIdOverrideID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	res, err := r.client.IdoverridegroupShow(&ipa.IdoverridegroupShowArgs{Idviewcn: idview, Ipaanchoruuid: anchor}, &ipa.IdoverridegroupShowOptionalArgs{All: &all})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Group id override not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa group id override: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("idview"), idview)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("anchor"), anchor)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), res.Result.Description)...)
+	}
+	if res.Result.Cn != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), res.Result.Cn)...)
+	}
+	if res.Result.Gidnumber != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gid_number"), int64(*res.Result.Gidnumber))...)
+	}
+}
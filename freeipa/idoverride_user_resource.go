@@ -0,0 +1,514 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdOverrideUserResource{}
+var _ resource.ResourceWithImportState = &IdOverrideUserResource{}
+
+func NewIdOverrideUserResource() resource.Resource {
+	return &IdOverrideUserResource{}
+}
+
+// IdOverrideUserResource defines the resource implementation.
+type IdOverrideUserResource struct {
+	client *ipa.Client
+}
+
+// IdOverrideUserResourceModel describes the resource data model.
+type IdOverrideUserResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	IdView           types.String `tfsdk:"idview"`
+	Anchor           types.String `tfsdk:"anchor"`
+	Description      types.String `tfsdk:"description"`
+	Login            types.String `tfsdk:"login"`
+	UidNumber        types.Int64  `tfsdk:"uid_number"`
+	GidNumber        types.Int64  `tfsdk:"gid_number"`
+	Gecos            types.String `tfsdk:"gecos"`
+	HomeDirectory    types.String `tfsdk:"home_directory"`
+	LoginShell       types.String `tfsdk:"login_shell"`
+	SshPublicKeys    types.List   `tfsdk:"ssh_public_keys"`
+	UserCertificates types.List   `tfsdk:"user_certificates"`
+}
+
+func (r *IdOverrideUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idoverride_user"
+}
+
+func (r *IdOverrideUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA user ID override resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idview": schema.StringAttribute{
+				MarkdownDescription: "Name of the ID view the override belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"anchor": schema.StringAttribute{
+				MarkdownDescription: "Name of the user to override (IPA user or trusted domain user)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "ID override description",
+				Optional:            true,
+			},
+			"login": schema.StringAttribute{
+				MarkdownDescription: "Overridden user login",
+				Optional:            true,
+			},
+			"uid_number": schema.Int64Attribute{
+				MarkdownDescription: "Overridden user ID number",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					idOverrideNumberRequiresReplaceIfRemoved(),
+				},
+			},
+			"gid_number": schema.Int64Attribute{
+				MarkdownDescription: "Overridden group ID number",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					idOverrideNumberRequiresReplaceIfRemoved(),
+				},
+			},
+			"gecos": schema.StringAttribute{
+				MarkdownDescription: "Overridden GECOS",
+				Optional:            true,
+			},
+			"home_directory": schema.StringAttribute{
+				MarkdownDescription: "Overridden home directory",
+				Optional:            true,
+			},
+			"login_shell": schema.StringAttribute{
+				MarkdownDescription: "Overridden login shell",
+				Optional:            true,
+			},
+			"ssh_public_keys": schema.ListAttribute{
+				MarkdownDescription: "Overridden SSH public keys",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"user_certificates": schema.ListAttribute{
+				MarkdownDescription: "Overridden base-64 encoded user certificates",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *IdOverrideUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdOverrideUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdOverrideUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.IdoverrideuserAddOptionalArgs{}
+
+	args := ipa.IdoverrideuserAddArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.Login.IsNull() {
+		optArgs.UID = data.Login.ValueStringPointer()
+	}
+	if !data.UidNumber.IsNull() {
+		uid := int(data.UidNumber.ValueInt64())
+		optArgs.Uidnumber = &uid
+	}
+	if !data.GidNumber.IsNull() {
+		gid := int(data.GidNumber.ValueInt64())
+		optArgs.Gidnumber = &gid
+	}
+	if !data.Gecos.IsNull() {
+		optArgs.Gecos = data.Gecos.ValueStringPointer()
+	}
+	if !data.HomeDirectory.IsNull() {
+		optArgs.Homedirectory = data.HomeDirectory.ValueStringPointer()
+	}
+	if !data.LoginShell.IsNull() {
+		optArgs.Loginshell = data.LoginShell.ValueStringPointer()
+	}
+	if !data.SshPublicKeys.IsNull() {
+		var v []string
+		for _, value := range data.SshPublicKeys.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipasshpubkey = &v
+	}
+	if !data.UserCertificates.IsNull() {
+		var v []interface{}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Usercertificate = &v
+	}
+
+	_, err := r.client.IdoverrideuserAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa user id override: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", encodeSlash(data.IdView.ValueString()), encodeSlash(data.Anchor.ValueString())))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdOverrideUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdOverrideUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idview, anchor, err := parseIdOverrideID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_idoverride_user: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.IdoverrideuserShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.IdoverrideuserShowArgs{
+		Idviewcn:      idview,
+		Ipaanchoruuid: anchor,
+	}
+
+	res, err := r.client.IdoverrideuserShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] User id override not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa user id override: %s", err))
+			return
+		}
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.UID != nil && !data.Login.IsNull() {
+		data.Login = types.StringValue(*res.Result.UID)
+	}
+	if res.Result.Uidnumber != nil && !data.UidNumber.IsNull() {
+		data.UidNumber = types.Int64Value(int64(*res.Result.Uidnumber))
+	}
+	if res.Result.Gidnumber != nil && !data.GidNumber.IsNull() {
+		data.GidNumber = types.Int64Value(int64(*res.Result.Gidnumber))
+	}
+	if res.Result.Gecos != nil && !data.Gecos.IsNull() {
+		data.Gecos = types.StringValue(*res.Result.Gecos)
+	}
+	if res.Result.Homedirectory != nil && !data.HomeDirectory.IsNull() {
+		data.HomeDirectory = types.StringValue(*res.Result.Homedirectory)
+	}
+	if res.Result.Loginshell != nil && !data.LoginShell.IsNull() {
+		data.LoginShell = types.StringValue(*res.Result.Loginshell)
+	}
+	if !data.SshPublicKeys.IsNull() && res.Result.Ipasshpubkey != nil {
+		var changedVals []string
+		for _, value := range data.SshPublicKeys.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if slices.Contains(*res.Result.Ipasshpubkey, val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.SshPublicKeys, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.UserCertificates.IsNull() && res.Result.Usercertificate != nil {
+		var changedVals, resVals []string
+		for _, v := range *res.Result.Usercertificate {
+			resVals = append(resVals, v.(string))
+		}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if slices.Contains(resVals, val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.UserCertificates, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdOverrideUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IdOverrideUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdoverrideuserModArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	optArgs := ipa.IdoverrideuserModOptionalArgs{}
+
+	var hasChange = false
+	// Overridden attributes that are removed from the configuration are cleared on the server, so the original value applies again.
+	empty := ""
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = &empty
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Login.Equal(state.Login) {
+		optArgs.UID = &empty
+		if !data.Login.IsNull() {
+			optArgs.UID = data.Login.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.UidNumber.Equal(state.UidNumber) && !data.UidNumber.IsNull() {
+		uid := int(data.UidNumber.ValueInt64())
+		optArgs.Uidnumber = &uid
+		hasChange = true
+	}
+	if !data.GidNumber.Equal(state.GidNumber) && !data.GidNumber.IsNull() {
+		gid := int(data.GidNumber.ValueInt64())
+		optArgs.Gidnumber = &gid
+		hasChange = true
+	}
+	if !data.Gecos.Equal(state.Gecos) {
+		optArgs.Gecos = &empty
+		if !data.Gecos.IsNull() {
+			optArgs.Gecos = data.Gecos.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.HomeDirectory.Equal(state.HomeDirectory) {
+		optArgs.Homedirectory = &empty
+		if !data.HomeDirectory.IsNull() {
+			optArgs.Homedirectory = data.HomeDirectory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.LoginShell.Equal(state.LoginShell) {
+		optArgs.Loginshell = &empty
+		if !data.LoginShell.IsNull() {
+			optArgs.Loginshell = data.LoginShell.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.SshPublicKeys.Equal(state.SshPublicKeys) {
+		v := []string{}
+		for _, value := range data.SshPublicKeys.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipasshpubkey = &v
+		hasChange = true
+	}
+	if !data.UserCertificates.Equal(state.UserCertificates) {
+		v := []interface{}{}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Usercertificate = &v
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.IdoverrideuserMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa user id override: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = state.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdOverrideUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdOverrideUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idview, anchor, err := parseIdOverrideID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_idoverride_user: %s", err))
+		return
+	}
+
+	args := ipa.IdoverrideuserDelArgs{
+		Idviewcn:      idview,
+		Ipaanchoruuid: []string{anchor},
+	}
+	_, err = r.client.IdoverrideuserDel(&args, &ipa.IdoverrideuserDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa user id override: %s", err))
+		return
+	}
+}
+
+func (r *IdOverrideUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idview, anchor, err := parseIdOverrideID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	res, err := r.client.IdoverrideuserShow(&ipa.IdoverrideuserShowArgs{Idviewcn: idview, Ipaanchoruuid: anchor}, &ipa.IdoverrideuserShowOptionalArgs{All: &all})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "User id override not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa user id override: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("idview"), idview)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("anchor"), anchor)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), res.Result.Description)...)
+	}
+	if res.Result.UID != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("login"), res.Result.UID)...)
+	}
+	if res.Result.Uidnumber != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uid_number"), int64(*res.Result.Uidnumber))...)
+	}
+	if res.Result.Gidnumber != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gid_number"), int64(*res.Result.Gidnumber))...)
+	}
+	if res.Result.Gecos != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gecos"), res.Result.Gecos)...)
+	}
+	if res.Result.Homedirectory != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("home_directory"), res.Result.Homedirectory)...)
+	}
+	if res.Result.Loginshell != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("login_shell"), res.Result.Loginshell)...)
+	}
+	if res.Result.Ipasshpubkey != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ssh_public_keys"), res.Result.Ipasshpubkey)...)
+	}
+}
+
+// The API cannot clear a numeric override, removing it from the configuration recreates the override.
+func idOverrideNumberRequiresReplaceIfRemoved() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+		},
+		"Removing a numeric ID override requires replacing the override.",
+		"Removing a numeric ID override requires replacing the override.",
+	)
+}
+
+func parseIdOverrideID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 {
+		return "", "", fmt.Errorf("unable to determine id override ID %s", id)
+	}
+
+	return decodeSlash(idParts[0]), decodeSlash(idParts[1]), nil
+}
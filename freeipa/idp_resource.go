@@ -0,0 +1,461 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdpResource{}
+var _ resource.ResourceWithImportState = &IdpResource{}
+var _ resource.ResourceWithConfigValidators = &IdpResource{}
+
+func NewIdpResource() resource.Resource {
+	return &IdpResource{}
+}
+
+// IdpResource defines the resource implementation.
+type IdpResource struct {
+	client *ipa.Client
+}
+
+// IdpResourceModel describes the resource data model.
+type IdpResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	ProviderTemplate      types.String `tfsdk:"provider_template"`
+	Organization          types.String `tfsdk:"organization"`
+	BaseUrl               types.String `tfsdk:"base_url"`
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	AuthUri               types.String `tfsdk:"auth_uri"`
+	DevAuthUri            types.String `tfsdk:"dev_auth_uri"`
+	TokenUri              types.String `tfsdk:"token_uri"`
+	UserinfoUri           types.String `tfsdk:"userinfo_uri"`
+	KeysUri               types.String `tfsdk:"keys_uri"`
+	IssuerUrl             types.String `tfsdk:"issuer_url"`
+	Scope                 types.String `tfsdk:"scope"`
+	IdpUserId             types.String `tfsdk:"idp_user_id"`
+}
+
+func (r *IdpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idp"
+}
+
+func (r *IdpResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("provider_template"),
+			path.MatchRoot("auth_uri"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("auth_uri"),
+			path.MatchRoot("dev_auth_uri"),
+			path.MatchRoot("token_uri"),
+			path.MatchRoot("userinfo_uri"),
+		),
+	}
+}
+
+func (r *IdpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA external identity provider resource.\n" +
+			"The endpoints are either computed by the server from a provider template (`provider_template`, `organization` and `base_url`) " +
+			"or set explicitly (`auth_uri`, `dev_auth_uri`, `token_uri` and `userinfo_uri`).\n\n" +
+			"The name of the identity provider can be referenced by the `external_idp_config` attribute of the `freeipa_user` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Identity provider name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_template": schema.StringAttribute{
+				MarkdownDescription: "Template used to compute the endpoints of a well known identity provider (`google`, `github`, `microsoft`, `okta` or `keycloak`). Use `microsoft` for Azure AD / Entra ID.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("google", "github", "microsoft", "okta", "keycloak"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Organization of the provider template: the realm for `keycloak`, the tenant ID for `microsoft`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the provider template, required for `keycloak` and `okta` (ie: `keycloak.example.lan:8443`)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 client identifier",
+				Required:            true,
+			},
+			"client_secret_wo": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 client secret. This attribute is write-only and never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"client_secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the client secret. Change it to update the identity provider with a new `client_secret_wo`.",
+				Optional:            true,
+			},
+			"auth_uri": schema.StringAttribute{
+				MarkdownDescription: "Authorization endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dev_auth_uri": schema.StringAttribute{
+				MarkdownDescription: "Device authorization endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_uri": schema.StringAttribute{
+				MarkdownDescription: "Token endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"userinfo_uri": schema.StringAttribute{
+				MarkdownDescription: "User information endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keys_uri": schema.StringAttribute{
+				MarkdownDescription: "JWKS endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issuer_url": schema.StringAttribute{
+				MarkdownDescription: "Issuer URL of the identity provider",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 scope. Multiple scopes are separated by a space (ie: `openid email`).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idp_user_id": schema.StringAttribute{
+				MarkdownDescription: "Attribute of the identity provider used to identify the user (ie: `email`)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IdpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config IdpResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.IdpAddOptionalArgs{}
+
+	args := ipa.IdpAddArgs{
+		Cn:             data.Name.ValueString(),
+		Ipaidpclientid: data.ClientId.ValueString(),
+	}
+	if !config.ClientSecretWo.IsNull() {
+		optArgs.Ipaidpclientsecret = config.ClientSecretWo.ValueStringPointer()
+	}
+	if !data.ProviderTemplate.IsNull() {
+		optArgs.Ipaidpprovider = data.ProviderTemplate.ValueStringPointer()
+	}
+	if !data.Organization.IsNull() {
+		optArgs.Ipaidporg = data.Organization.ValueStringPointer()
+	}
+	if !data.BaseUrl.IsNull() {
+		optArgs.Ipaidpbaseurl = data.BaseUrl.ValueStringPointer()
+	}
+	if !data.AuthUri.IsUnknown() && !data.AuthUri.IsNull() {
+		optArgs.Ipaidpauthendpoint = data.AuthUri.ValueStringPointer()
+	}
+	if !data.DevAuthUri.IsUnknown() && !data.DevAuthUri.IsNull() {
+		optArgs.Ipaidpdevauthendpoint = data.DevAuthUri.ValueStringPointer()
+	}
+	if !data.TokenUri.IsUnknown() && !data.TokenUri.IsNull() {
+		optArgs.Ipaidptokenendpoint = data.TokenUri.ValueStringPointer()
+	}
+	if !data.UserinfoUri.IsUnknown() && !data.UserinfoUri.IsNull() {
+		optArgs.Ipaidpuserinfoendpoint = data.UserinfoUri.ValueStringPointer()
+	}
+	if !data.KeysUri.IsUnknown() && !data.KeysUri.IsNull() {
+		optArgs.Ipaidpkeysendpoint = data.KeysUri.ValueStringPointer()
+	}
+	if !data.IssuerUrl.IsUnknown() && !data.IssuerUrl.IsNull() {
+		optArgs.Ipaidpissuerurl = data.IssuerUrl.ValueStringPointer()
+	}
+	if !data.Scope.IsUnknown() && !data.Scope.IsNull() {
+		optArgs.Ipaidpscope = data.Scope.ValueStringPointer()
+	}
+	if !data.IdpUserId.IsUnknown() && !data.IdpUserId.IsNull() {
+		optArgs.Ipaidpsub = data.IdpUserId.ValueStringPointer()
+	}
+
+	res, err := r.client.IdpAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa idp: %s", err))
+		return
+	}
+
+	data.Id = data.Name
+	// The endpoints computed from the provider template are only known after the creation.
+	setIdpComputedAttributes(&data, res.Result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdpResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.IdpShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.IdpShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.IdpShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Idp not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa idp: %s", err))
+			return
+		}
+	}
+
+	data.Name = types.StringValue(res.Result.Cn)
+	data.ClientId = types.StringValue(res.Result.Ipaidpclientid)
+	setIdpComputedAttributes(&data, res.Result)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state, config IdpResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdpModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.IdpModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.ClientId.Equal(state.ClientId) {
+		optArgs.Ipaidpclientid = data.ClientId.ValueStringPointer()
+		hasChange = true
+	}
+	// The client secret is never read back, it is only sent when its version changes.
+	if !data.ClientSecretWoVersion.Equal(state.ClientSecretWoVersion) {
+		if config.ClientSecretWo.IsNull() {
+			v := ""
+			optArgs.Ipaidpclientsecret = &v
+		} else {
+			optArgs.Ipaidpclientsecret = config.ClientSecretWo.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.AuthUri.Equal(state.AuthUri) && !data.AuthUri.IsUnknown() {
+		optArgs.Ipaidpauthendpoint = data.AuthUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.DevAuthUri.Equal(state.DevAuthUri) && !data.DevAuthUri.IsUnknown() {
+		optArgs.Ipaidpdevauthendpoint = data.DevAuthUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.TokenUri.Equal(state.TokenUri) && !data.TokenUri.IsUnknown() {
+		optArgs.Ipaidptokenendpoint = data.TokenUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.UserinfoUri.Equal(state.UserinfoUri) && !data.UserinfoUri.IsUnknown() {
+		optArgs.Ipaidpuserinfoendpoint = data.UserinfoUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.KeysUri.Equal(state.KeysUri) && !data.KeysUri.IsUnknown() {
+		optArgs.Ipaidpkeysendpoint = data.KeysUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.IssuerUrl.Equal(state.IssuerUrl) && !data.IssuerUrl.IsUnknown() {
+		optArgs.Ipaidpissuerurl = data.IssuerUrl.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Scope.Equal(state.Scope) && !data.Scope.IsUnknown() {
+		optArgs.Ipaidpscope = data.Scope.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.IdpUserId.Equal(state.IdpUserId) && !data.IdpUserId.IsUnknown() {
+		optArgs.Ipaidpsub = data.IdpUserId.ValueStringPointer()
+		hasChange = true
+	}
+
+	if hasChange {
+		res, err := r.client.IdpMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa idp: %s", err))
+				return
+			}
+		} else {
+			setIdpComputedAttributes(&data, res.Result)
+		}
+	}
+
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdpResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdpDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.IdpDel(&args, &ipa.IdpDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa idp: %s", err))
+		return
+	}
+}
+
+func (r *IdpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setIdpComputedAttributes sets the endpoints and claims returned by the server,
+// they are computed from the provider template when they are not configured.
+func setIdpComputedAttributes(data *IdpResourceModel, idp ipa.Idp) {
+	data.AuthUri = types.StringPointerValue(idp.Ipaidpauthendpoint)
+	data.DevAuthUri = types.StringPointerValue(idp.Ipaidpdevauthendpoint)
+	data.TokenUri = types.StringPointerValue(idp.Ipaidptokenendpoint)
+	data.UserinfoUri = types.StringPointerValue(idp.Ipaidpuserinfoendpoint)
+	data.KeysUri = types.StringPointerValue(idp.Ipaidpkeysendpoint)
+	data.IssuerUrl = types.StringPointerValue(idp.Ipaidpissuerurl)
+	data.Scope = types.StringPointerValue(idp.Ipaidpscope)
+	data.IdpUserId = types.StringPointerValue(idp.Ipaidpsub)
+}
@@ -0,0 +1,134 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAIdp_template(t *testing.T) {
+	testIdp := map[string]string{
+		"index":             "0",
+		"name":              "\"testacc-keycloak\"",
+		"client_id":         "\"testacc-client\"",
+		"provider_template": "\"keycloak\"",
+		"organization":      "\"testacc\"",
+		"base_url":          "\"keycloak.testacc.ipatest.lan:8443\"",
+		"client_secret_wo":  "\"Secret123\"",
+	}
+	testIdpModified := map[string]string{
+		"index":                    "0",
+		"name":                     "\"testacc-keycloak\"",
+		"client_id":                "\"testacc-client-2\"",
+		"provider_template":        "\"keycloak\"",
+		"organization":             "\"testacc\"",
+		"base_url":                 "\"keycloak.testacc.ipatest.lan:8443\"",
+		"client_secret_wo":         "\"Secret456\"",
+		"client_secret_wo_version": "2",
+		"idp_user_id":              "\"email\"",
+	}
+	testUser := map[string]string{
+		"index":                 "0",
+		"login":                 "\"testacc-user-0\"",
+		"firstname":             "\"Test\"",
+		"lastname":              "\"User0\"",
+		"auth_type":             "[\"idp\"]",
+		"external_idp_config":   "freeipa_idp.idp-0.name",
+		"external_idp_username": "\"testacc-user-0@testacc.ipatest.lan\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "name", "testacc-keycloak"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "client_id", "testacc-client"),
+					resource.TestMatchResourceAttr("freeipa_idp.idp-0", "auth_uri", regexp.MustCompile("^https://keycloak.testacc.ipatest.lan:8443/realms/testacc/")),
+					resource.TestCheckResourceAttrSet("freeipa_idp.idp-0", "token_uri"),
+					resource.TestCheckNoResourceAttr("freeipa_idp.idp-0", "client_secret_wo"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdpModified) + testAccFreeIPAUser_resource(testUser),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_idp.idp-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "client_id", "testacc-client-2"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "idp_user_id", "email"),
+					resource.TestCheckResourceAttr("freeipa_user.user-0", "external_idp_config", "testacc-keycloak"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdpModified) + testAccFreeIPAUser_resource(testUser),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAIdp_custom(t *testing.T) {
+	testIdp := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-custom\"",
+		"client_id":    "\"testacc-client\"",
+		"auth_uri":     "\"https://sso.testacc.ipatest.lan/oauth2/authorize\"",
+		"dev_auth_uri": "\"https://sso.testacc.ipatest.lan/oauth2/device\"",
+		"token_uri":    "\"https://sso.testacc.ipatest.lan/oauth2/token\"",
+		"userinfo_uri": "\"https://sso.testacc.ipatest.lan/oauth2/userinfo\"",
+		"scope":        "\"openid email\"",
+	}
+	testIdpInvalid := map[string]string{
+		"index":             "0",
+		"name":              "\"testacc-custom\"",
+		"client_id":         "\"testacc-client\"",
+		"provider_template": "\"okta\"",
+		"auth_uri":          "\"https://sso.testacc.ipatest.lan/oauth2/authorize\"",
+		"dev_auth_uri":      "\"https://sso.testacc.ipatest.lan/oauth2/device\"",
+		"token_uri":         "\"https://sso.testacc.ipatest.lan/oauth2/token\"",
+		"userinfo_uri":      "\"https://sso.testacc.ipatest.lan/oauth2/userinfo\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdpInvalid),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "auth_uri", "https://sso.testacc.ipatest.lan/oauth2/authorize"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "token_uri", "https://sso.testacc.ipatest.lan/oauth2/token"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "scope", "openid email"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
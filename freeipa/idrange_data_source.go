@@ -0,0 +1,193 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IdRangesDataSource{}
+var _ datasource.DataSourceWithConfigure = &IdRangesDataSource{}
+
+func NewIdRangesDataSource() datasource.DataSource {
+	return &IdRangesDataSource{}
+}
+
+// IdRangesDataSource defines the data source implementation.
+type IdRangesDataSource struct {
+	client *ipa.Client
+}
+
+// IdRangesDataSourceModel describes the data source data model.
+type IdRangesDataSourceModel struct {
+	Id        types.String             `tfsdk:"id"`
+	RangeType types.String             `tfsdk:"range_type"`
+	Ranges    []IdRangeDataSourceModel `tfsdk:"ranges"`
+}
+
+// IdRangeDataSourceModel describes an id range defined on the server.
+type IdRangeDataSourceModel struct {
+	Name              types.String `tfsdk:"name"`
+	BaseId            types.Int64  `tfsdk:"base_id"`
+	Size              types.Int64  `tfsdk:"size"`
+	RidBase           types.Int64  `tfsdk:"rid_base"`
+	SecondaryRidBase  types.Int64  `tfsdk:"secondary_rid_base"`
+	RangeType         types.String `tfsdk:"range_type"`
+	AutoPrivateGroups types.String `tfsdk:"auto_private_groups"`
+	TrustedDomainSid  types.String `tfsdk:"trusted_domain_sid"`
+}
+
+func (r *IdRangesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idranges"
+}
+
+func (r *IdRangesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA ID ranges data source.\nLists all the ID ranges defined on the server.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"range_type": schema.StringAttribute{
+				MarkdownDescription: "Only list the ranges of this type (`ipa-local`, `ipa-ad-trust` or `ipa-ad-trust-posix`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(idRangeTypeLocal, "ipa-ad-trust", "ipa-ad-trust-posix"),
+				},
+			},
+			"ranges": schema.ListNestedAttribute{
+				MarkdownDescription: "ID ranges defined on the server",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the ID range",
+							Computed:            true,
+						},
+						"base_id": schema.Int64Attribute{
+							MarkdownDescription: "First Posix ID of the range",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Number of IDs in the range",
+							Computed:            true,
+						},
+						"rid_base": schema.Int64Attribute{
+							MarkdownDescription: "First RID of the corresponding RID range",
+							Computed:            true,
+						},
+						"secondary_rid_base": schema.Int64Attribute{
+							MarkdownDescription: "First RID of the secondary RID range",
+							Computed:            true,
+						},
+						"range_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the range",
+							Computed:            true,
+						},
+						"auto_private_groups": schema.StringAttribute{
+							MarkdownDescription: "Auto creation of private groups for users of a trusted domain",
+							Computed:            true,
+						},
+						"trusted_domain_sid": schema.StringAttribute{
+							MarkdownDescription: "Domain SID of the trusted domain",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *IdRangesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdRangesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IdRangesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.IdrangeFindOptionalArgs{
+		All: &all,
+	}
+	if !data.RangeType.IsNull() {
+		optArgs.Iparangetype = data.RangeType.ValueStringPointer()
+	}
+
+	res, err := r.client.IdrangeFind("", &ipa.IdrangeFindArgs{}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa id ranges: %s", err))
+		return
+	}
+
+	data.Ranges = []IdRangeDataSourceModel{}
+	for _, idrange := range res.Result {
+		idRange := IdRangeDataSourceModel{
+			Name:              types.StringValue(idrange.Cn),
+			BaseId:            types.Int64Value(int64(idrange.Ipabaseid)),
+			Size:              types.Int64Value(int64(idrange.Ipaidrangesize)),
+			RidBase:           types.Int64Null(),
+			SecondaryRidBase:  types.Int64Null(),
+			RangeType:         types.StringPointerValue(idrange.Iparangetype),
+			AutoPrivateGroups: types.StringPointerValue(idrange.Ipaautoprivategroups),
+			TrustedDomainSid:  types.StringPointerValue(idrange.Ipanttrusteddomainsid),
+		}
+		if idrange.Ipabaserid != nil {
+			idRange.RidBase = types.Int64Value(int64(*idrange.Ipabaserid))
+		}
+		if idrange.Ipasecondarybaserid != nil {
+			idRange.SecondaryRidBase = types.Int64Value(int64(*idrange.Ipasecondarybaserid))
+		}
+		data.Ranges = append(data.Ranges, idRange)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read %d freeipa id ranges", len(data.Ranges)))
+	data.Id = types.StringValue("idranges")
+	if !data.RangeType.IsNull() {
+		data.Id = types.StringValue(fmt.Sprintf("idranges/%s", data.RangeType.ValueString()))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,433 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+const idRangeTypeLocal = "ipa-local"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdRangeResource{}
+var _ resource.ResourceWithImportState = &IdRangeResource{}
+
+func NewIdRangeResource() resource.Resource {
+	return &IdRangeResource{}
+}
+
+// IdRangeResource defines the resource implementation.
+type IdRangeResource struct {
+	client *ipa.Client
+}
+
+// IdRangeResourceModel describes the resource data model.
+type IdRangeResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	BaseId            types.Int64  `tfsdk:"base_id"`
+	Size              types.Int64  `tfsdk:"size"`
+	RidBase           types.Int64  `tfsdk:"rid_base"`
+	SecondaryRidBase  types.Int64  `tfsdk:"secondary_rid_base"`
+	RangeType         types.String `tfsdk:"range_type"`
+	AutoPrivateGroups types.String `tfsdk:"auto_private_groups"`
+	TrustedDomainSid  types.String `tfsdk:"trusted_domain_sid"`
+	TrustedDomainName types.String `tfsdk:"trusted_domain_name"`
+}
+
+func (r *IdRangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idrange"
+}
+
+func (r *IdRangeResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("trusted_domain_sid"),
+			path.MatchRoot("trusted_domain_name"),
+		),
+	}
+}
+
+func (r *IdRangeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA ID range resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the ID range",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_id": schema.Int64Attribute{
+				MarkdownDescription: "First Posix ID of the range",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Number of IDs in the range",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rid_base": schema.Int64Attribute{
+				MarkdownDescription: "First RID of the corresponding RID range",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"secondary_rid_base": schema.Int64Attribute{
+				MarkdownDescription: "First RID of the secondary RID range (local ranges only)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"range_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the range (`ipa-local`, `ipa-ad-trust` or `ipa-ad-trust-posix`). Defaults to `ipa-local`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(idRangeTypeLocal),
+				Validators: []validator.String{
+					stringvalidator.OneOf(idRangeTypeLocal, "ipa-ad-trust", "ipa-ad-trust-posix"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_private_groups": schema.StringAttribute{
+				MarkdownDescription: "Auto creation of private groups for users of a trusted domain (`true`, `false` or `hybrid`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("true", "false", "hybrid"),
+				},
+			},
+			"trusted_domain_sid": schema.StringAttribute{
+				MarkdownDescription: "Domain SID of the trusted domain (trust ranges only)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trusted_domain_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the trusted domain (trust ranges only). The server resolves it to the domain SID.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IdRangeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdRangeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.IdrangeAddOptionalArgs{}
+
+	args := ipa.IdrangeAddArgs{
+		Cn:             data.Name.ValueString(),
+		Ipabaseid:      int(data.BaseId.ValueInt64()),
+		Ipaidrangesize: int(data.Size.ValueInt64()),
+	}
+	if !data.RidBase.IsUnknown() && !data.RidBase.IsNull() {
+		v := int(data.RidBase.ValueInt64())
+		optArgs.Ipabaserid = &v
+	}
+	if !data.SecondaryRidBase.IsUnknown() && !data.SecondaryRidBase.IsNull() {
+		v := int(data.SecondaryRidBase.ValueInt64())
+		optArgs.Ipasecondarybaserid = &v
+	}
+	if !data.RangeType.IsNull() {
+		optArgs.Iparangetype = data.RangeType.ValueStringPointer()
+	}
+	if !data.AutoPrivateGroups.IsNull() {
+		optArgs.Ipaautoprivategroups = data.AutoPrivateGroups.ValueStringPointer()
+	}
+	if !data.TrustedDomainSid.IsNull() {
+		optArgs.Ipanttrusteddomainsid = data.TrustedDomainSid.ValueStringPointer()
+	}
+	if !data.TrustedDomainName.IsNull() {
+		optArgs.Ipanttrusteddomainname = data.TrustedDomainName.ValueStringPointer()
+	}
+
+	res, err := r.client.IdrangeAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa id range: %s", err))
+		return
+	}
+
+	data.RidBase = types.Int64Null()
+	if res.Result.Ipabaserid != nil {
+		data.RidBase = types.Int64Value(int64(*res.Result.Ipabaserid))
+	}
+	data.SecondaryRidBase = types.Int64Null()
+	if res.Result.Ipasecondarybaserid != nil {
+		data.SecondaryRidBase = types.Int64Value(int64(*res.Result.Ipasecondarybaserid))
+	}
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdRangeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.IdrangeShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.IdrangeShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.IdrangeShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] ID range not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa id range: %s", err))
+			return
+		}
+	}
+
+	data.Name = types.StringValue(res.Result.Cn)
+	data.BaseId = types.Int64Value(int64(res.Result.Ipabaseid))
+	data.Size = types.Int64Value(int64(res.Result.Ipaidrangesize))
+	if res.Result.Ipabaserid != nil {
+		data.RidBase = types.Int64Value(int64(*res.Result.Ipabaserid))
+	}
+	if res.Result.Ipasecondarybaserid != nil {
+		data.SecondaryRidBase = types.Int64Value(int64(*res.Result.Ipasecondarybaserid))
+	}
+	if res.Result.Iparangetype != nil {
+		data.RangeType = types.StringValue(*res.Result.Iparangetype)
+	}
+	if res.Result.Ipaautoprivategroups != nil && !data.AutoPrivateGroups.IsNull() {
+		data.AutoPrivateGroups = types.StringValue(*res.Result.Ipaautoprivategroups)
+	}
+	if res.Result.Ipanttrusteddomainsid != nil && !data.TrustedDomainSid.IsNull() {
+		data.TrustedDomainSid = types.StringValue(*res.Result.Ipanttrusteddomainsid)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IdRangeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdrangeModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.IdrangeModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.BaseId.Equal(state.BaseId) {
+		v := int(data.BaseId.ValueInt64())
+		optArgs.Ipabaseid = &v
+		hasChange = true
+	}
+	if !data.Size.Equal(state.Size) {
+		v := int(data.Size.ValueInt64())
+		optArgs.Ipaidrangesize = &v
+		hasChange = true
+	}
+	if !data.RidBase.IsUnknown() && !data.RidBase.Equal(state.RidBase) {
+		v := int(data.RidBase.ValueInt64())
+		optArgs.Ipabaserid = &v
+		hasChange = true
+	}
+	if !data.SecondaryRidBase.IsUnknown() && !data.SecondaryRidBase.Equal(state.SecondaryRidBase) {
+		v := int(data.SecondaryRidBase.ValueInt64())
+		optArgs.Ipasecondarybaserid = &v
+		hasChange = true
+	}
+	if !data.AutoPrivateGroups.Equal(state.AutoPrivateGroups) {
+		if data.AutoPrivateGroups.IsNull() {
+			v := ""
+			optArgs.Ipaautoprivategroups = &v
+		} else {
+			optArgs.Ipaautoprivategroups = data.AutoPrivateGroups.ValueStringPointer()
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		res, err := r.client.IdrangeMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa id range: %s", err))
+				return
+			}
+		} else {
+			if res.Result.Ipabaserid != nil {
+				data.RidBase = types.Int64Value(int64(*res.Result.Ipabaserid))
+			}
+			if res.Result.Ipasecondarybaserid != nil {
+				data.SecondaryRidBase = types.Int64Value(int64(*res.Result.Ipasecondarybaserid))
+			}
+		}
+	}
+	if data.RidBase.IsUnknown() {
+		data.RidBase = state.RidBase
+	}
+	if data.SecondaryRidBase.IsUnknown() {
+		data.SecondaryRidBase = state.SecondaryRidBase
+	}
+
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdRangeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdrangeDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.IdrangeDel(&args, &ipa.IdrangeDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa id range: %s", err))
+		return
+	}
+}
+
+func (r *IdRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkIdInLocalIdRanges returns a warning on the attribute when the id is not part of
+// any local id range defined on the server. Nothing is reported if the ranges cannot be read.
+func checkIdInLocalIdRanges(ctx context.Context, client *ipa.Client, attr path.Path, id int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if client == nil {
+		return diags
+	}
+	all := true
+	res, err := client.IdrangeFind("", &ipa.IdrangeFindArgs{}, &ipa.IdrangeFindOptionalArgs{All: &all})
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Unable to read freeipa id ranges: %s", err))
+		return diags
+	}
+
+	var localRanges []string
+	for _, idrange := range res.Result {
+		if idrange.Iparangetype != nil && *idrange.Iparangetype != idRangeTypeLocal {
+			continue
+		}
+		if id >= int64(idrange.Ipabaseid) && id < int64(idrange.Ipabaseid)+int64(idrange.Ipaidrangesize) {
+			return diags
+		}
+		localRanges = append(localRanges, fmt.Sprintf("%s (%d-%d)", idrange.Cn, idrange.Ipabaseid, idrange.Ipabaseid+idrange.Ipaidrangesize-1))
+	}
+	if len(localRanges) == 0 {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		attr,
+		"ID outside of local ID ranges",
+		fmt.Sprintf("The ID %d is not part of any local ID range defined on the server: %s. "+
+			"The server will accept it, but it may collide with IDs allocated for another range.", id, strings.Join(localRanges, ", ")),
+	)
+	return diags
+}
@@ -0,0 +1,82 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAIdRange(t *testing.T) {
+	testIdRange := map[string]string{
+		"index":              "0",
+		"name":               "\"testacc-idrange\"",
+		"base_id":            "500000000",
+		"size":               "10000",
+		"rid_base":           "500000000",
+		"secondary_rid_base": "600000000",
+	}
+	testIdRangeModified := map[string]string{
+		"index":              "0",
+		"name":               "\"testacc-idrange\"",
+		"base_id":            "500000000",
+		"size":               "20000",
+		"rid_base":           "500000000",
+		"secondary_rid_base": "600000000",
+	}
+	testIdRangesDS := map[string]string{
+		"index":      "0",
+		"range_type": "\"ipa-local\"",
+		"depends_on": "[freeipa_idrange.idrange-0]",
+	}
+	testUser := map[string]string{
+		"index":      "0",
+		"login":      "\"testacc-user-0\"",
+		"firstname":  "\"Test\"",
+		"lastname":   "\"User0\"",
+		"uid_number": "500000100",
+		"gid_number": "500000100",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdRange_resource(testIdRange),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idrange.idrange-0", "name", "testacc-idrange"),
+					resource.TestCheckResourceAttr("freeipa_idrange.idrange-0", "base_id", "500000000"),
+					resource.TestCheckResourceAttr("freeipa_idrange.idrange-0", "size", "10000"),
+					resource.TestCheckResourceAttr("freeipa_idrange.idrange-0", "range_type", "ipa-local"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdRange_resource(testIdRangeModified) + testAccFreeIPAIdRanges_datasource(testIdRangesDS) + testAccFreeIPAUser_resource(testUser),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idrange.idrange-0", "size", "20000"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.freeipa_idranges.idranges-0", "ranges.*", map[string]string{
+						"name":       "testacc-idrange",
+						"base_id":    "500000000",
+						"size":       "20000",
+						"range_type": "ipa-local",
+					}),
+					resource.TestCheckResourceAttr("freeipa_user.user-0", "uid_number", "500000100"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdRange_resource(testIdRangeModified) + testAccFreeIPAIdRanges_datasource(testIdRangesDS) + testAccFreeIPAUser_resource(testUser),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
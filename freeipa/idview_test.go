@@ -0,0 +1,156 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAIdView_overrides(t *testing.T) {
+	testIdView := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-idview\"",
+		"description": "\"An ID view for acceptance tests\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-group-0\"",
+		"description": "\"User group test 0\"",
+	}
+	testUserOverride := map[string]string{
+		"index":          "0",
+		"idview":         "freeipa_idview.idview-0.name",
+		"anchor":         "freeipa_user.user-0.name",
+		"uid_number":     "20000",
+		"gid_number":     "20000",
+		"login_shell":    "\"/bin/zsh\"",
+		"home_directory": "\"/export/home/testacc-user-0\"",
+	}
+	testUserOverrideModified := map[string]string{
+		"index":           "0",
+		"idview":          "freeipa_idview.idview-0.name",
+		"anchor":          "freeipa_user.user-0.name",
+		"description":     "\"Legacy account mapping\"",
+		"login":           "\"tuser0\"",
+		"uid_number":      "20001",
+		"gid_number":      "20000",
+		"login_shell":     "\"/bin/bash\"",
+		"ssh_public_keys": "[\"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGJ3x5pzbzLzEzB7t4jsQSyk0b5YH3jVQZJ1Y9vRs2wW testacc\"]",
+	}
+	testGroupOverride := map[string]string{
+		"index":      "0",
+		"idview":     "freeipa_idview.idview-0.name",
+		"anchor":     "freeipa_group.group-0.name",
+		"name":       "\"legacy-group\"",
+		"gid_number": "20000",
+	}
+
+	base := testAccFreeIPAProvider() + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAIdOverrideGroup_resource(testGroupOverride)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPAIdOverrideUser_resource(testUserOverride),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview.idview-0", "name", "testacc-idview"),
+					resource.TestCheckResourceAttr("freeipa_idview.idview-0", "description", "An ID view for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "id", "testacc-idview/testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "uid_number", "20000"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "login_shell", "/bin/zsh"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_group.idoverride-group-0", "name", "legacy-group"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_group.idoverride-group-0", "gid_number", "20000"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAIdOverrideUser_resource(testUserOverrideModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "description", "Legacy account mapping"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "login", "tuser0"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "uid_number", "20001"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "login_shell", "/bin/bash"),
+					resource.TestCheckNoResourceAttr("freeipa_idoverride_user.idoverride-user-0", "home_directory"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "ssh_public_keys.#", "1"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAIdOverrideUser_resource(testUserOverrideModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAIdView_hostMembership(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testIdView := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-idview\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_idview.idview-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"identifier": "\"hosts-0\"",
+	}
+	testHostDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_host.host-0.name",
+	}
+
+	base := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAIdView_resource(testIdView)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPAIdViewHostMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview_host_membership.idview-host-membership-0", "id", "testacc-idview/mu/hosts-0"),
+					resource.TestCheckResourceAttr("freeipa_idview_host_membership.idview-host-membership-0", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_idview_host_membership.idview-host-membership-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAIdViewHostMembership_resource(testMembership) + testAccFreeIPAHost_datasource(testHostDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_host.host-0", "assigned_idview", "testacc-idview"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAIdViewHostMembership_resource(testMembership) + testAccFreeIPAHost_datasource(testHostDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
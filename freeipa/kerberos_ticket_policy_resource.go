@@ -0,0 +1,524 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// ID of the kerberos ticket policy resource when it targets the global policy.
+const globalKerberosTicketPolicyId = "global"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KerberosTicketPolicyResource{}
+var _ resource.ResourceWithImportState = &KerberosTicketPolicyResource{}
+
+func NewKerberosTicketPolicyResource() resource.Resource {
+	return &KerberosTicketPolicyResource{}
+}
+
+// KerberosTicketPolicyResource defines the resource implementation.
+type KerberosTicketPolicyResource struct {
+	client *ipa.Client
+}
+
+// KerberosTicketPolicyResourceModel describes the resource data model.
+type KerberosTicketPolicyResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	User             types.String `tfsdk:"user"`
+	MaxLife          types.Int64  `tfsdk:"max_life"`
+	MaxRenew         types.Int64  `tfsdk:"max_renew"`
+	OtpMaxLife       types.Int64  `tfsdk:"otp_max_life"`
+	OtpMaxRenew      types.Int64  `tfsdk:"otp_max_renew"`
+	RadiusMaxLife    types.Int64  `tfsdk:"radius_max_life"`
+	RadiusMaxRenew   types.Int64  `tfsdk:"radius_max_renew"`
+	PkinitMaxLife    types.Int64  `tfsdk:"pkinit_max_life"`
+	PkinitMaxRenew   types.Int64  `tfsdk:"pkinit_max_renew"`
+	HardenedMaxLife  types.Int64  `tfsdk:"hardened_max_life"`
+	HardenedMaxRenew types.Int64  `tfsdk:"hardened_max_renew"`
+	IdpMaxLife       types.Int64  `tfsdk:"idp_max_life"`
+	IdpMaxRenew      types.Int64  `tfsdk:"idp_max_renew"`
+	PasskeyMaxLife   types.Int64  `tfsdk:"passkey_max_life"`
+	PasskeyMaxRenew  types.Int64  `tfsdk:"passkey_max_renew"`
+}
+
+func (r *KerberosTicketPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kerberos_ticket_policy"
+}
+
+func (r *KerberosTicketPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Kerberos ticket policy resource.\nThe policy applies to the user given in `user`, or to the global policy of the realm when `user` is not set. Destroying the resource resets the policy to its defaults.\nRemoving an attribute from the configuration stops managing it, the value in FreeIPA is left unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource. `global` for the global policy, the user name otherwise.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Manage the ticket policy of this user. The global ticket policy is managed if not set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_life": schema.Int64Attribute{
+				MarkdownDescription: "Maximum ticket life (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Maximum renewable age (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"otp_max_life": schema.Int64Attribute{
+				MarkdownDescription: "OTP token maximum ticket life (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"otp_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "OTP token ticket maximum renewable age (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"radius_max_life": schema.Int64Attribute{
+				MarkdownDescription: "RADIUS token maximum ticket life (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"radius_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "RADIUS token ticket maximum renewable age (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"pkinit_max_life": schema.Int64Attribute{
+				MarkdownDescription: "PKINIT token maximum ticket life (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"pkinit_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "PKINIT token ticket maximum renewable age (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"hardened_max_life": schema.Int64Attribute{
+				MarkdownDescription: "Hardened token maximum ticket life (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"hardened_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Hardened token ticket maximum renewable age (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"idp_max_life": schema.Int64Attribute{
+				MarkdownDescription: "External IdP token maximum ticket life (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"idp_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "External IdP token ticket maximum renewable age (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"passkey_max_life": schema.Int64Attribute{
+				MarkdownDescription: "Passkey token maximum ticket life (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"passkey_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Passkey token ticket maximum renewable age (seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *KerberosTicketPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KerberosTicketPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KerberosTicketPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ticket policy always exists (inherited from the global policy for users), creating it means modifying it.
+	optArgs := ipa.KrbtpolicyModOptionalArgs{}
+	if !data.User.IsNull() {
+		optArgs.Uid = data.User.ValueStringPointer()
+	}
+	if !data.MaxLife.IsNull() {
+		v := int(data.MaxLife.ValueInt64())
+		optArgs.Krbmaxticketlife = &v
+	}
+	if !data.MaxRenew.IsNull() {
+		v := int(data.MaxRenew.ValueInt64())
+		optArgs.Krbmaxrenewableage = &v
+	}
+	if !data.OtpMaxLife.IsNull() {
+		v := int(data.OtpMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifeOtp = &v
+	}
+	if !data.OtpMaxRenew.IsNull() {
+		v := int(data.OtpMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableageOtp = &v
+	}
+	if !data.RadiusMaxLife.IsNull() {
+		v := int(data.RadiusMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifeRadius = &v
+	}
+	if !data.RadiusMaxRenew.IsNull() {
+		v := int(data.RadiusMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableageRadius = &v
+	}
+	if !data.PkinitMaxLife.IsNull() {
+		v := int(data.PkinitMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifePkinit = &v
+	}
+	if !data.PkinitMaxRenew.IsNull() {
+		v := int(data.PkinitMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableagePkinit = &v
+	}
+	if !data.HardenedMaxLife.IsNull() {
+		v := int(data.HardenedMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifeHardened = &v
+	}
+	if !data.HardenedMaxRenew.IsNull() {
+		v := int(data.HardenedMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableageHardened = &v
+	}
+	if !data.IdpMaxLife.IsNull() {
+		v := int(data.IdpMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifeIdp = &v
+	}
+	if !data.IdpMaxRenew.IsNull() {
+		v := int(data.IdpMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableageIdp = &v
+	}
+	if !data.PasskeyMaxLife.IsNull() {
+		v := int(data.PasskeyMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifePasskey = &v
+	}
+	if !data.PasskeyMaxRenew.IsNull() {
+		v := int(data.PasskeyMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableagePasskey = &v
+	}
+
+	_, err := r.client.KrbtpolicyMod(&ipa.KrbtpolicyModArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddWarning("Client Warning", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa kerberos ticket policy: %s", err))
+			return
+		}
+	}
+
+	if data.User.IsNull() {
+		data.Id = types.StringValue(globalKerberosTicketPolicyId)
+	} else {
+		data.Id = data.User
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KerberosTicketPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KerberosTicketPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.KrbtpolicyShowOptionalArgs{
+		All: &all,
+	}
+	if !data.User.IsNull() {
+		optArgs.Uid = data.User.ValueStringPointer()
+	}
+
+	res, err := r.client.KrbtpolicyShow(&ipa.KrbtpolicyShowArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Kerberos ticket policy not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa kerberos ticket policy: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa kerberos ticket policy %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa kerberos ticket policy %s", data.Id.ValueString()))
+		return
+	}
+
+	if res.Result.Krbmaxticketlife != nil && !data.MaxLife.IsNull() {
+		data.MaxLife = types.Int64Value(int64(*res.Result.Krbmaxticketlife))
+	}
+	if res.Result.Krbmaxrenewableage != nil && !data.MaxRenew.IsNull() {
+		data.MaxRenew = types.Int64Value(int64(*res.Result.Krbmaxrenewableage))
+	}
+	if res.Result.KrbauthindmaxticketlifeOtp != nil && !data.OtpMaxLife.IsNull() {
+		data.OtpMaxLife = types.Int64Value(int64(*res.Result.KrbauthindmaxticketlifeOtp))
+	}
+	if res.Result.KrbauthindmaxrenewableageOtp != nil && !data.OtpMaxRenew.IsNull() {
+		data.OtpMaxRenew = types.Int64Value(int64(*res.Result.KrbauthindmaxrenewableageOtp))
+	}
+	if res.Result.KrbauthindmaxticketlifeRadius != nil && !data.RadiusMaxLife.IsNull() {
+		data.RadiusMaxLife = types.Int64Value(int64(*res.Result.KrbauthindmaxticketlifeRadius))
+	}
+	if res.Result.KrbauthindmaxrenewableageRadius != nil && !data.RadiusMaxRenew.IsNull() {
+		data.RadiusMaxRenew = types.Int64Value(int64(*res.Result.KrbauthindmaxrenewableageRadius))
+	}
+	if res.Result.KrbauthindmaxticketlifePkinit != nil && !data.PkinitMaxLife.IsNull() {
+		data.PkinitMaxLife = types.Int64Value(int64(*res.Result.KrbauthindmaxticketlifePkinit))
+	}
+	if res.Result.KrbauthindmaxrenewableagePkinit != nil && !data.PkinitMaxRenew.IsNull() {
+		data.PkinitMaxRenew = types.Int64Value(int64(*res.Result.KrbauthindmaxrenewableagePkinit))
+	}
+	if res.Result.KrbauthindmaxticketlifeHardened != nil && !data.HardenedMaxLife.IsNull() {
+		data.HardenedMaxLife = types.Int64Value(int64(*res.Result.KrbauthindmaxticketlifeHardened))
+	}
+	if res.Result.KrbauthindmaxrenewableageHardened != nil && !data.HardenedMaxRenew.IsNull() {
+		data.HardenedMaxRenew = types.Int64Value(int64(*res.Result.KrbauthindmaxrenewableageHardened))
+	}
+	if res.Result.KrbauthindmaxticketlifeIdp != nil && !data.IdpMaxLife.IsNull() {
+		data.IdpMaxLife = types.Int64Value(int64(*res.Result.KrbauthindmaxticketlifeIdp))
+	}
+	if res.Result.KrbauthindmaxrenewableageIdp != nil && !data.IdpMaxRenew.IsNull() {
+		data.IdpMaxRenew = types.Int64Value(int64(*res.Result.KrbauthindmaxrenewableageIdp))
+	}
+	if res.Result.KrbauthindmaxticketlifePasskey != nil && !data.PasskeyMaxLife.IsNull() {
+		data.PasskeyMaxLife = types.Int64Value(int64(*res.Result.KrbauthindmaxticketlifePasskey))
+	}
+	if res.Result.KrbauthindmaxrenewableagePasskey != nil && !data.PasskeyMaxRenew.IsNull() {
+		data.PasskeyMaxRenew = types.Int64Value(int64(*res.Result.KrbauthindmaxrenewableagePasskey))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *KerberosTicketPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state KerberosTicketPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.KrbtpolicyModOptionalArgs{}
+	if !data.User.IsNull() {
+		optArgs.Uid = data.User.ValueStringPointer()
+	}
+
+	var hasChange = false
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa kerberos ticket policy %s from plan = %v", state.Id.ValueString(), data))
+	if !data.MaxLife.Equal(state.MaxLife) && !data.MaxLife.IsNull() {
+		v := int(data.MaxLife.ValueInt64())
+		optArgs.Krbmaxticketlife = &v
+		hasChange = true
+	}
+	if !data.MaxRenew.Equal(state.MaxRenew) && !data.MaxRenew.IsNull() {
+		v := int(data.MaxRenew.ValueInt64())
+		optArgs.Krbmaxrenewableage = &v
+		hasChange = true
+	}
+	if !data.OtpMaxLife.Equal(state.OtpMaxLife) && !data.OtpMaxLife.IsNull() {
+		v := int(data.OtpMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifeOtp = &v
+		hasChange = true
+	}
+	if !data.OtpMaxRenew.Equal(state.OtpMaxRenew) && !data.OtpMaxRenew.IsNull() {
+		v := int(data.OtpMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableageOtp = &v
+		hasChange = true
+	}
+	if !data.RadiusMaxLife.Equal(state.RadiusMaxLife) && !data.RadiusMaxLife.IsNull() {
+		v := int(data.RadiusMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifeRadius = &v
+		hasChange = true
+	}
+	if !data.RadiusMaxRenew.Equal(state.RadiusMaxRenew) && !data.RadiusMaxRenew.IsNull() {
+		v := int(data.RadiusMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableageRadius = &v
+		hasChange = true
+	}
+	if !data.PkinitMaxLife.Equal(state.PkinitMaxLife) && !data.PkinitMaxLife.IsNull() {
+		v := int(data.PkinitMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifePkinit = &v
+		hasChange = true
+	}
+	if !data.PkinitMaxRenew.Equal(state.PkinitMaxRenew) && !data.PkinitMaxRenew.IsNull() {
+		v := int(data.PkinitMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableagePkinit = &v
+		hasChange = true
+	}
+	if !data.HardenedMaxLife.Equal(state.HardenedMaxLife) && !data.HardenedMaxLife.IsNull() {
+		v := int(data.HardenedMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifeHardened = &v
+		hasChange = true
+	}
+	if !data.HardenedMaxRenew.Equal(state.HardenedMaxRenew) && !data.HardenedMaxRenew.IsNull() {
+		v := int(data.HardenedMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableageHardened = &v
+		hasChange = true
+	}
+	if !data.IdpMaxLife.Equal(state.IdpMaxLife) && !data.IdpMaxLife.IsNull() {
+		v := int(data.IdpMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifeIdp = &v
+		hasChange = true
+	}
+	if !data.IdpMaxRenew.Equal(state.IdpMaxRenew) && !data.IdpMaxRenew.IsNull() {
+		v := int(data.IdpMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableageIdp = &v
+		hasChange = true
+	}
+	if !data.PasskeyMaxLife.Equal(state.PasskeyMaxLife) && !data.PasskeyMaxLife.IsNull() {
+		v := int(data.PasskeyMaxLife.ValueInt64())
+		optArgs.KrbauthindmaxticketlifePasskey = &v
+		hasChange = true
+	}
+	if !data.PasskeyMaxRenew.Equal(state.PasskeyMaxRenew) && !data.PasskeyMaxRenew.IsNull() {
+		v := int(data.PasskeyMaxRenew.ValueInt64())
+		optArgs.KrbauthindmaxrenewableagePasskey = &v
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.KrbtpolicyMod(&ipa.KrbtpolicyModArgs{}, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa kerberos ticket policy %s: %s", state.Id.ValueString(), err))
+				return
+			}
+		}
+	}
+
+	data.Id = state.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KerberosTicketPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KerberosTicketPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Reset freeipa kerberos ticket policy Id %s", data.Id.ValueString()))
+	optArgs := ipa.KrbtpolicyResetOptionalArgs{}
+	if !data.User.IsNull() {
+		optArgs.Uid = data.User.ValueStringPointer()
+	}
+	_, err := r.client.KrbtpolicyReset(&ipa.KrbtpolicyResetArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Kerberos ticket policy user not found, nothing to reset")
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Kerberos ticket policy %s reset failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *KerberosTicketPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if req.ID != globalKerberosTicketPolicyId {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), req.ID)...)
+	}
+}
@@ -0,0 +1,69 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAKerberosTicketPolicy_user(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testPolicy := map[string]string{
+		"index":     "0",
+		"user":      "freeipa_user.user-0.name",
+		"max_life":  "3600",
+		"max_renew": "7200",
+	}
+	testPolicyModified := map[string]string{
+		"index":           "0",
+		"user":            "freeipa_user.user-0.name",
+		"max_life":        "7200",
+		"max_renew":       "14400",
+		"otp_max_life":    "1800",
+		"otp_max_renew":   "3600",
+		"pkinit_max_life": "43200",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.kerberos-ticket-policy-0", "id", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.kerberos-ticket-policy-0", "max_life", "3600"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.kerberos-ticket-policy-0", "max_renew", "7200"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testPolicyModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.kerberos-ticket-policy-0", "max_life", "7200"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.kerberos-ticket-policy-0", "otp_max_life", "1800"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.kerberos-ticket-policy-0", "otp_max_renew", "3600"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.kerberos-ticket-policy-0", "pkinit_max_life", "43200"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testPolicyModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,387 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetgroupResource{}
+var _ resource.ResourceWithImportState = &NetgroupResource{}
+
+func NewNetgroupResource() resource.Resource {
+	return &NetgroupResource{}
+}
+
+// NetgroupResource defines the resource implementation.
+type NetgroupResource struct {
+	client *ipa.Client
+}
+
+// NetgroupResourceModel describes the resource data model.
+type NetgroupResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	NisDomain    types.String `tfsdk:"nisdomain"`
+	UserCategory types.String `tfsdk:"usercategory"`
+	HostCategory types.String `tfsdk:"hostcategory"`
+	ExternalHost types.List   `tfsdk:"external_host"`
+}
+
+func (r *NetgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_netgroup"
+}
+
+func (r *NetgroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *NetgroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA netgroup resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the netgroup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Netgroup description",
+				Optional:            true,
+			},
+			"nisdomain": schema.StringAttribute{
+				MarkdownDescription: "NIS domain name. Defaults to the IPA domain.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usercategory": schema.StringAttribute{
+				MarkdownDescription: "User category the netgroup is applied to (allowed value: all)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all"),
+				},
+			},
+			"hostcategory": schema.StringAttribute{
+				MarkdownDescription: "Host category the netgroup is applied to (allowed value: all)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all"),
+				},
+			},
+			"external_host": schema.ListAttribute{
+				MarkdownDescription: "List of hosts that are not managed by FreeIPA to add to the netgroup",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *NetgroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.NetgroupAddOptionalArgs{}
+
+	args := ipa.NetgroupAddArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.NisDomain.IsUnknown() && !data.NisDomain.IsNull() {
+		optArgs.Nisdomainname = data.NisDomain.ValueStringPointer()
+	}
+	if !data.UserCategory.IsNull() {
+		optArgs.Usercategory = data.UserCategory.ValueStringPointer()
+	}
+	if !data.HostCategory.IsNull() {
+		optArgs.Hostcategory = data.HostCategory.ValueStringPointer()
+	}
+	if !data.ExternalHost.IsNull() {
+		var v []string
+		for _, value := range data.ExternalHost.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Externalhost = &v
+	}
+	res, err := r.client.NetgroupAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa netgroup: %s", err))
+		return
+	}
+
+	if res.Result.Nisdomainname != nil {
+		data.NisDomain = types.StringValue(*res.Result.Nisdomainname)
+	} else {
+		data.NisDomain = types.StringNull()
+	}
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.NetgroupShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.NetgroupShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.NetgroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Netgroup not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa netgroup: %s", err))
+			return
+		}
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Nisdomainname != nil {
+		data.NisDomain = types.StringValue(*res.Result.Nisdomainname)
+	}
+	if res.Result.Usercategory != nil && !data.UserCategory.IsNull() {
+		data.UserCategory = types.StringValue(*res.Result.Usercategory)
+	}
+	if res.Result.Hostcategory != nil && !data.HostCategory.IsNull() {
+		data.HostCategory = types.StringValue(*res.Result.Hostcategory)
+	}
+	if !data.ExternalHost.IsNull() {
+		var changedVals []string
+		for _, value := range data.ExternalHost.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if res.Result.Externalhost != nil && slices.Contains(*res.Result.Externalhost, val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.ExternalHost, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *NetgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NetgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.NetgroupModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.NetgroupModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = data.Description.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.NisDomain.IsUnknown() && !data.NisDomain.Equal(state.NisDomain) {
+		optArgs.Nisdomainname = data.NisDomain.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.UserCategory.Equal(state.UserCategory) {
+		if data.UserCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Usercategory = &v
+		} else {
+			optArgs.Usercategory = data.UserCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.HostCategory.Equal(state.HostCategory) {
+		if data.HostCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Hostcategory = &v
+		} else {
+			optArgs.Hostcategory = data.HostCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.ExternalHost.Equal(state.ExternalHost) {
+		v := []string{}
+		for _, value := range data.ExternalHost.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Externalhost = &v
+		hasChange = true
+	}
+
+	if hasChange {
+		res, err := r.client.NetgroupMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa netgroup: %s", err))
+				return
+			}
+		} else if res.Result.Nisdomainname != nil {
+			data.NisDomain = types.StringValue(*res.Result.Nisdomainname)
+		}
+	}
+	if data.NisDomain.IsUnknown() {
+		data.NisDomain = state.NisDomain
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.NetgroupDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.NetgroupDel(&args, &ipa.NetgroupDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa netgroup: %s", err))
+		return
+	}
+}
+
+func (r *NetgroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	all := true
+	optArgs := ipa.NetgroupShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.NetgroupShowArgs{
+		Cn: req.ID,
+	}
+
+	res, err := r.client.NetgroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Netgroup not found")
+			return
+		} else {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa netgroup: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), res.Result.Description)...)
+	}
+	if res.Result.Usercategory != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("usercategory"), res.Result.Usercategory)...)
+	}
+	if res.Result.Hostcategory != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostcategory"), res.Result.Hostcategory)...)
+	}
+	if res.Result.Externalhost != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("external_host"), res.Result.Externalhost)...)
+	}
+}
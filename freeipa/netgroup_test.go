@@ -0,0 +1,170 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPANetgroup_simple(t *testing.T) {
+	testNetgroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-netgroup\"",
+		"description": "\"A netgroup for acceptance tests\"",
+	}
+	testNetgroupModified := map[string]string{
+		"index":         "0",
+		"name":          "\"testacc-netgroup\"",
+		"description":   "\"A new netgroup for acceptance tests\"",
+		"nisdomain":     "\"testacc.nis\"",
+		"usercategory":  "\"all\"",
+		"hostcategory":  "\"all\"",
+		"external_host": "[\"nis-client-0.external.lan\", \"nis-client-1.external.lan\"]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroup),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "name", "testacc-netgroup"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "description", "A netgroup for acceptance tests"),
+					resource.TestCheckResourceAttrSet("freeipa_netgroup.netgroup-0", "nisdomain"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroup),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroupModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "description", "A new netgroup for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "nisdomain", "testacc.nis"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "usercategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "hostcategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "external_host.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "external_host.0", "nis-client-0.external.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroupModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPANetgroup_membership(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-group-0\"",
+		"description": "\"User group test 0\"",
+	}
+	testNetgroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-netgroup\"",
+	}
+	testMemberNetgroup := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-netgroup-member\"",
+	}
+	testUserMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_netgroup.netgroup-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"identifier": "\"users-0\"",
+	}
+	testUserMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_netgroup.netgroup-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"groups":     "[freeipa_group.group-0.name]",
+		"identifier": "\"users-0\"",
+	}
+	testHostMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_netgroup.netgroup-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"identifier": "\"hosts-0\"",
+	}
+	testNetgroupMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_netgroup.netgroup-0.name",
+		"netgroups":  "[freeipa_netgroup.netgroup-1.name]",
+		"identifier": "\"netgroups-0\"",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPANetgroup_resource(testNetgroup) + testAccFreeIPANetgroup_resource(testMemberNetgroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPANetgroupUserMembership_resource(testUserMembership) + testAccFreeIPANetgroupHostMembership_resource(testHostMembership) + testAccFreeIPANetgroupNetgroupMembership_resource(testNetgroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_netgroup_user_membership.netgroup-user-membership-0", "id", "testacc-netgroup/mnu/users-0"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_user_membership.netgroup-user-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_user_membership.netgroup-user-membership-0", "users.0", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_host_membership.netgroup-host-membership-0", "id", "testacc-netgroup/mnh/hosts-0"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_host_membership.netgroup-host-membership-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_host_membership.netgroup-host-membership-0", "hostgroups.0", "testacc-hostgroup"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_netgroup_membership.netgroup-netgroup-membership-0", "id", "testacc-netgroup/mnn/netgroups-0"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_netgroup_membership.netgroup-netgroup-membership-0", "netgroups.0", "testacc-netgroup-member"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPANetgroupUserMembership_resource(testUserMembershipModified) + testAccFreeIPANetgroupHostMembership_resource(testHostMembership) + testAccFreeIPANetgroupNetgroupMembership_resource(testNetgroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_netgroup_user_membership.netgroup-user-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_user_membership.netgroup-user-membership-0", "groups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_user_membership.netgroup-user-membership-0", "groups.0", "testacc-group-0"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPANetgroupUserMembership_resource(testUserMembershipModified) + testAccFreeIPANetgroupHostMembership_resource(testHostMembership) + testAccFreeIPANetgroupNetgroupMembership_resource(testNetgroupMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
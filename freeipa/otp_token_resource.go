@@ -0,0 +1,580 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OtpTokenResource{}
+var _ resource.ResourceWithImportState = &OtpTokenResource{}
+
+func NewOtpTokenResource() resource.Resource {
+	return &OtpTokenResource{}
+}
+
+// OtpTokenResource defines the resource implementation.
+type OtpTokenResource struct {
+	client *ipa.Client
+}
+
+// OtpTokenResourceModel describes the resource data model.
+type OtpTokenResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	UniqueId    types.String `tfsdk:"unique_id"`
+	Owner       types.String `tfsdk:"owner"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Algorithm   types.String `tfsdk:"algorithm"`
+	Digits      types.Int64  `tfsdk:"digits"`
+	Interval    types.Int64  `tfsdk:"interval"`
+	Counter     types.Int64  `tfsdk:"counter"`
+	NotBefore   types.String `tfsdk:"not_before"`
+	NotAfter    types.String `tfsdk:"not_after"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	ManagedBy   types.List   `tfsdk:"managed_by"`
+	Uri         types.String `tfsdk:"uri"`
+	Secret      types.String `tfsdk:"secret"`
+}
+
+func (r *OtpTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_otp_token"
+}
+
+func (r *OtpTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA OTP token resource.\nThe provisioning URI and the secret are only returned by FreeIPA when the token is created, they are not available after an import.\nThe owner can only authenticate with the token if `otp` is part of its `auth_type` (or of the global default authentication types).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unique_id": schema.StringAttribute{
+				MarkdownDescription: "Unique ID of the token. Generated by FreeIPA if not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Login of the user owning the token. Defaults to the user the provider authenticates with.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the token (totp, hotp). Defaults to `totp`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("totp"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("totp", "hotp"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Token description",
+				Optional:            true,
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "Token hash algorithm (sha1, sha256, sha384, sha512). Defaults to `sha1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("sha1"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("sha1", "sha256", "sha384", "sha512"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"digits": schema.Int64Attribute{
+				MarkdownDescription: "Number of digits of the generated codes (6, 8). Defaults to `6`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(6),
+				Validators: []validator.Int64{
+					int64validator.OneOf(6, 8),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"interval": schema.Int64Attribute{
+				MarkdownDescription: "Length of the TOTP time-step in seconds. Only used by `totp` tokens, FreeIPA defaults to 30.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(5),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"counter": schema.Int64Attribute{
+				MarkdownDescription: "Initial counter of the token. Only used by `hotp` tokens, FreeIPA defaults to 0.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "Token validity start " +
+					"[RFC3339](https://datatracker.ietf.org/doc/html/rfc3339#section-5.8) format " +
+					"(see [RFC3339 time string](https://tools.ietf.org/html/rfc3339#section-5.8) e.g., " +
+					"`YYYY-MM-DDTHH:MM:SSZ`)",
+				Optional: true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "Token validity end " +
+					"[RFC3339](https://datatracker.ietf.org/doc/html/rfc3339#section-5.8) format " +
+					"(see [RFC3339 time string](https://tools.ietf.org/html/rfc3339#section-5.8) e.g., " +
+					"`YYYY-MM-DDTHH:MM:SSZ`)",
+				Optional: true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Disable the token. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"managed_by": schema.ListAttribute{
+				MarkdownDescription: "List of users allowed to manage the token",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "Provisioning URI (`otpauth://...`) of the token, to be imported in an authenticator application",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Base32 encoded secret key of the token",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OtpTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OtpTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OtpTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	noQrcode := true
+	optArgs := ipa.OtptokenAddOptionalArgs{
+		NoQrcode:             &noQrcode,
+		Type:                 data.Type.ValueStringPointer(),
+		Ipatokenotpalgorithm: data.Algorithm.ValueStringPointer(),
+		Ipatokendisabled:     data.Disabled.ValueBoolPointer(),
+	}
+	if !data.UniqueId.IsUnknown() && !data.UniqueId.IsNull() {
+		optArgs.Ipatokenuniqueid = data.UniqueId.ValueStringPointer()
+	}
+	if !data.Owner.IsUnknown() && !data.Owner.IsNull() {
+		optArgs.Ipatokenowner = data.Owner.ValueStringPointer()
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.Digits.IsNull() {
+		v := int(data.Digits.ValueInt64())
+		optArgs.Ipatokenotpdigits = &v
+	}
+	if !data.Interval.IsNull() {
+		v := int(data.Interval.ValueInt64())
+		optArgs.Ipatokentotptimestep = &v
+	}
+	if !data.Counter.IsNull() {
+		v := int(data.Counter.ValueInt64())
+		optArgs.Ipatokenhotpcounter = &v
+	}
+	if !data.NotBefore.IsNull() {
+		timestamp, err := time.Parse(time.RFC3339, data.NotBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Attribute format", fmt.Sprintf("The not_before timestamp could not be parsed as RFC3339: %s", err))
+			return
+		}
+		optArgs.Ipatokennotbefore = &timestamp
+	}
+	if !data.NotAfter.IsNull() {
+		timestamp, err := time.Parse(time.RFC3339, data.NotAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Attribute format", fmt.Sprintf("The not_after timestamp could not be parsed as RFC3339: %s", err))
+			return
+		}
+		optArgs.Ipatokennotafter = &timestamp
+	}
+
+	res, err := r.client.OtptokenAdd(&ipa.OtptokenAddArgs{}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa otp token: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(res.Result.Ipatokenuniqueid)
+	data.UniqueId = types.StringValue(res.Result.Ipatokenuniqueid)
+	data.Owner = types.StringPointerValue(res.Result.Ipatokenowner)
+	data.Uri = types.StringPointerValue(res.Result.URI)
+	data.Secret = types.StringNull()
+	if res.Result.URI != nil {
+		u, err := url.Parse(*res.Result.URI)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing the uri of freeipa otp token: %s", err))
+		} else {
+			data.Secret = types.StringValue(u.Query().Get("secret"))
+		}
+	}
+
+	if len(data.ManagedBy.Elements()) > 0 {
+		var v []string
+		for _, value := range data.ManagedBy.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		_, err := r.client.OtptokenAddManagedby(&ipa.OtptokenAddManagedbyArgs{Ipatokenuniqueid: data.Id.ValueString()}, &ipa.OtptokenAddManagedbyOptionalArgs{User: &v})
+		if err != nil {
+			// The token is already created, it is saved in the state to be tracked and the error marks it as tainted.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error adding managers of freeipa otp token: %s", err))
+			return
+		}
+	}
+
+	if !data.Owner.IsNull() {
+		resp.Diagnostics.Append(checkOtpAuthType(ctx, r.client, data.Owner.ValueString())...)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OtpTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OtpTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.OtptokenShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.OtptokenShowArgs{
+		Ipatokenuniqueid: data.Id.ValueString(),
+	}
+
+	res, err := r.client.OtptokenShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] OTP token not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa otp token: %s", err))
+			return
+		}
+	}
+
+	data.UniqueId = types.StringValue(res.Result.Ipatokenuniqueid)
+	data.Owner = types.StringPointerValue(res.Result.Ipatokenowner)
+	if res.Result.Type != nil {
+		data.Type = types.StringValue(strings.ToLower(*res.Result.Type))
+	}
+	if res.Result.Ipatokenotpalgorithm != nil {
+		data.Algorithm = types.StringValue(*res.Result.Ipatokenotpalgorithm)
+	}
+	if res.Result.Ipatokenotpdigits != nil {
+		data.Digits = types.Int64Value(int64(*res.Result.Ipatokenotpdigits))
+	}
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipatokentotptimestep != nil && !data.Interval.IsNull() {
+		data.Interval = types.Int64Value(int64(*res.Result.Ipatokentotptimestep))
+	}
+	data.NotBefore = otpTokenTimestampValue(data.NotBefore, res.Result.Ipatokennotbefore)
+	data.NotAfter = otpTokenTimestampValue(data.NotAfter, res.Result.Ipatokennotafter)
+	if res.Result.Ipatokendisabled != nil {
+		data.Disabled = types.BoolValue(*res.Result.Ipatokendisabled)
+	} else {
+		data.Disabled = types.BoolValue(false)
+	}
+	if !data.ManagedBy.IsNull() {
+		var diag diag.Diagnostics
+		managers := []string{}
+		if res.Result.ManagedbyUser != nil {
+			managers = *res.Result.ManagedbyUser
+		}
+		data.ManagedBy, diag = types.ListValueFrom(ctx, types.StringType, managers)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OtpTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OtpTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.OtptokenModArgs{
+		Ipatokenuniqueid: state.Id.ValueString(),
+	}
+	optArgs := ipa.OtptokenModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Owner.IsUnknown() && !data.Owner.Equal(state.Owner) {
+		optArgs.Ipatokenowner = data.Owner.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Disabled.Equal(state.Disabled) {
+		optArgs.Ipatokendisabled = data.Disabled.ValueBoolPointer()
+		hasChange = true
+	}
+	// Removed validity timestamps are cleared by setting an empty value
+	var clearAttrs []string
+	if !data.NotBefore.Equal(state.NotBefore) && data.NotBefore.IsNull() {
+		clearAttrs = append(clearAttrs, "ipatokennotbefore=")
+		hasChange = true
+	}
+	if !data.NotAfter.Equal(state.NotAfter) && data.NotAfter.IsNull() {
+		clearAttrs = append(clearAttrs, "ipatokennotafter=")
+		hasChange = true
+	}
+	if len(clearAttrs) > 0 {
+		optArgs.Setattr = &clearAttrs
+	}
+	if !data.NotBefore.Equal(state.NotBefore) && !data.NotBefore.IsNull() {
+		timestamp, err := time.Parse(time.RFC3339, data.NotBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Attribute format", fmt.Sprintf("The not_before timestamp could not be parsed as RFC3339: %s", err))
+			return
+		}
+		optArgs.Ipatokennotbefore = &timestamp
+		hasChange = true
+	}
+	if !data.NotAfter.Equal(state.NotAfter) && !data.NotAfter.IsNull() {
+		timestamp, err := time.Parse(time.RFC3339, data.NotAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Attribute format", fmt.Sprintf("The not_after timestamp could not be parsed as RFC3339: %s", err))
+			return
+		}
+		optArgs.Ipatokennotafter = &timestamp
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.OtptokenMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa otp token: %s", err))
+				return
+			}
+		}
+	}
+
+	// Managers can be added or removed, comparing the current state and the plan allows us to define 2 lists of users to add or remove.
+	if !data.ManagedBy.Equal(state.ManagedBy) {
+		var statearr, planarr, addedUsers, deletedUsers []string
+
+		for _, value := range state.ManagedBy.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.ManagedBy.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedUsers = append(addedUsers, val)
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedUsers = append(deletedUsers, value)
+			}
+		}
+		if len(addedUsers) > 0 {
+			_, err := r.client.OtptokenAddManagedby(&ipa.OtptokenAddManagedbyArgs{Ipatokenuniqueid: state.Id.ValueString()}, &ipa.OtptokenAddManagedbyOptionalArgs{User: &addedUsers})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error adding managers of freeipa otp token: %s", err))
+				return
+			}
+		}
+		if len(deletedUsers) > 0 {
+			_, err := r.client.OtptokenRemoveManagedby(&ipa.OtptokenRemoveManagedbyArgs{Ipatokenuniqueid: state.Id.ValueString()}, &ipa.OtptokenRemoveManagedbyOptionalArgs{User: &deletedUsers})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing managers of freeipa otp token: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = state.Id
+	data.UniqueId = state.UniqueId
+	if data.Owner.IsUnknown() {
+		data.Owner = state.Owner
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OtpTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OtpTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.OtptokenDelArgs{
+		Ipatokenuniqueid: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.OtptokenDel(&args, &ipa.OtptokenDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa otp token: %s", err))
+		return
+	}
+}
+
+func (r *OtpTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkOtpAuthType returns a warning if the user has explicit authentication types that do not allow OTP authentication.
+// An empty list means the global default authentication types of the IPA configuration apply.
+func checkOtpAuthType(ctx context.Context, client *ipa.Client, uid string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	res, err := client.UserShow(&ipa.UserShowArgs{}, &ipa.UserShowOptionalArgs{UID: &uid})
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Could not check the authentication types of user %s: %s", uid, err))
+		return diags
+	}
+	if res.Result.Ipauserauthtype != nil && len(*res.Result.Ipauserauthtype) > 0 && !slices.Contains(*res.Result.Ipauserauthtype, "otp") {
+		diags.AddAttributeWarning(
+			path.Root("owner"),
+			"OTP authentication not enabled",
+			fmt.Sprintf("The user %s cannot authenticate with this token because `otp` is not part of its auth_type (%s).", uid, strings.Join(*res.Result.Ipauserauthtype, ", ")),
+		)
+	}
+	return diags
+}
+
+// otpTokenTimestampValue returns the timestamp read from the server, keeping the configured value
+// when it represents the same instant in another time zone.
+func otpTokenTimestampValue(current types.String, timestamp *time.Time) types.String {
+	if timestamp == nil {
+		return types.StringNull()
+	}
+	if !current.IsNull() {
+		configured, err := time.Parse(time.RFC3339, current.ValueString())
+		if err == nil && configured.Equal(*timestamp) {
+			return current
+		}
+	}
+	return types.StringValue(timestamp.UTC().Format(time.RFC3339))
+}
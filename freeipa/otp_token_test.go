@@ -0,0 +1,164 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAOtpToken_totp(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testManager := map[string]string{
+		"index":     "1",
+		"login":     "\"testacc-user-1\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User1\"",
+	}
+	testToken := map[string]string{
+		"index":       "0",
+		"unique_id":   "\"testacc-totp\"",
+		"owner":       "freeipa_user.user-0.name",
+		"description": "\"TOTP token for acceptance tests\"",
+		"algorithm":   "\"sha256\"",
+		"digits":      "8",
+		"interval":    "60",
+	}
+	testTokenModified := map[string]string{
+		"index":       "0",
+		"unique_id":   "\"testacc-totp\"",
+		"owner":       "freeipa_user.user-0.name",
+		"description": "\"TOTP token for acceptance tests (modified)\"",
+		"algorithm":   "\"sha256\"",
+		"digits":      "8",
+		"interval":    "60",
+		"not_after":   "\"2030-01-01T00:00:00Z\"",
+		"disabled":    "true",
+		"managed_by":  "[freeipa_user.user-1.name]",
+	}
+	testTokenNoExpiry := map[string]string{
+		"index":       "0",
+		"unique_id":   "\"testacc-totp\"",
+		"owner":       "freeipa_user.user-0.name",
+		"description": "\"TOTP token for acceptance tests (modified)\"",
+		"algorithm":   "\"sha256\"",
+		"digits":      "8",
+		"interval":    "60",
+		"disabled":    "true",
+		"managed_by":  "[freeipa_user.user-1.name]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUser_resource(testManager) + testAccFreeIPAOtpToken_resource(testToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "unique_id", "testacc-totp"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "owner", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "type", "totp"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "algorithm", "sha256"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "digits", "8"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "interval", "60"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "disabled", "false"),
+					resource.TestMatchResourceAttr("freeipa_otp_token.otp-token-0", "uri", regexp.MustCompile("^otpauth://totp/")),
+					resource.TestMatchResourceAttr("freeipa_otp_token.otp-token-0", "secret", regexp.MustCompile("^[A-Z2-7=]+$")),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUser_resource(testManager) + testAccFreeIPAOtpToken_resource(testTokenModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_otp_token.otp-token-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "description", "TOTP token for acceptance tests (modified)"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "not_after", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "disabled", "true"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "managed_by.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "managed_by.0", "testacc-user-1"),
+					resource.TestMatchResourceAttr("freeipa_otp_token.otp-token-0", "uri", regexp.MustCompile("^otpauth://totp/")),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUser_resource(testManager) + testAccFreeIPAOtpToken_resource(testTokenModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUser_resource(testManager) + testAccFreeIPAOtpToken_resource(testTokenNoExpiry),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_otp_token.otp-token-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_otp_token.otp-token-0", "not_after"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUser_resource(testManager) + testAccFreeIPAOtpToken_resource(testTokenNoExpiry),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAOtpToken_hotp(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testToken := map[string]string{
+		"index":   "0",
+		"owner":   "freeipa_user.user-0.name",
+		"type":    "\"hotp\"",
+		"counter": "10",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAOtpToken_resource(testToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("freeipa_otp_token.otp-token-0", "unique_id"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "type", "hotp"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "counter", "10"),
+					resource.TestMatchResourceAttr("freeipa_otp_token.otp-token-0", "uri", regexp.MustCompile("^otpauth://hotp/")),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAOtpToken_resource(testToken),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
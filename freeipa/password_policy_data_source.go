@@ -0,0 +1,229 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PasswordPolicyDataSource{}
+var _ datasource.DataSourceWithConfigure = &PasswordPolicyDataSource{}
+
+func NewPasswordPolicyDataSource() datasource.DataSource {
+	return &PasswordPolicyDataSource{}
+}
+
+// PasswordPolicyDataSource defines the data source implementation.
+type PasswordPolicyDataSource struct {
+	client *ipa.Client
+}
+
+// PasswordPolicyDataSourceModel describes the data source data model.
+type PasswordPolicyDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Priority        types.Int64  `tfsdk:"priority"`
+	MaxLifetime     types.Int64  `tfsdk:"max_lifetime"`
+	MinLifetime     types.Int64  `tfsdk:"min_lifetime"`
+	HistoryLength   types.Int64  `tfsdk:"history_length"`
+	MinClasses      types.Int64  `tfsdk:"min_classes"`
+	MinLength       types.Int64  `tfsdk:"min_length"`
+	MaxFailures     types.Int64  `tfsdk:"max_failures"`
+	FailureInterval types.Int64  `tfsdk:"failure_interval"`
+	LockoutDuration types.Int64  `tfsdk:"lockout_duration"`
+	MaxRepeat       types.Int64  `tfsdk:"max_repeat"`
+	MaxSequence     types.Int64  `tfsdk:"max_sequence"`
+	DictionaryCheck types.Bool   `tfsdk:"dictionary_check"`
+	UserCheck       types.Bool   `tfsdk:"user_check"`
+	GraceLoginLimit types.Int64  `tfsdk:"grace_login_limit"`
+}
+
+func (r *PasswordPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy"
+}
+
+func (r *PasswordPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Password policy data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the group the password policy applies to, or `global_policy` for the global password policy",
+				Required:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the policy (higher number means lower priority).",
+				Computed:            true,
+			},
+			"max_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Maximum password lifetime (in days)",
+				Computed:            true,
+			},
+			"min_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Minimum password lifetime (in hours)",
+				Computed:            true,
+			},
+			"history_length": schema.Int64Attribute{
+				MarkdownDescription: "Password history size",
+				Computed:            true,
+			},
+			"min_classes": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of character classes",
+				Computed:            true,
+			},
+			"min_length": schema.Int64Attribute{
+				MarkdownDescription: "Minimum length of password",
+				Computed:            true,
+			},
+			"max_failures": schema.Int64Attribute{
+				MarkdownDescription: "Consecutive failures before lockout",
+				Computed:            true,
+			},
+			"failure_interval": schema.Int64Attribute{
+				MarkdownDescription: "Period after which failure count will be reset (in seconds)",
+				Computed:            true,
+			},
+			"lockout_duration": schema.Int64Attribute{
+				MarkdownDescription: "Period for which lockout is enforced (in seconds)",
+				Computed:            true,
+			},
+			"max_repeat": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of same consecutive characters",
+				Computed:            true,
+			},
+			"max_sequence": schema.Int64Attribute{
+				MarkdownDescription: "The maximum length of monotonic character sequences (abcd)",
+				Computed:            true,
+			},
+			"dictionary_check": schema.BoolAttribute{
+				MarkdownDescription: "Check if the password is a dictionary word",
+				Computed:            true,
+			},
+			"user_check": schema.BoolAttribute{
+				MarkdownDescription: "Check if the password contains the username",
+				Computed:            true,
+			},
+			"grace_login_limit": schema.Int64Attribute{
+				MarkdownDescription: "Number of LDAP authentications allowed after expiration",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PasswordPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PasswordPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PasswordPolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	name := data.Name.ValueString()
+	optArgs := ipa.PwpolicyShowOptionalArgs{
+		All: &all,
+		Cn:  &name,
+	}
+
+	res, err := r.client.PwpolicyShow(&ipa.PwpolicyShowArgs{}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa password policy %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa password policy %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Cospriority != nil {
+		data.Priority = types.Int64Value(int64(*res.Result.Cospriority))
+	}
+	if res.Result.Krbmaxpwdlife != nil {
+		data.MaxLifetime = types.Int64Value(int64(*res.Result.Krbmaxpwdlife))
+	}
+	if res.Result.Krbminpwdlife != nil {
+		data.MinLifetime = types.Int64Value(int64(*res.Result.Krbminpwdlife))
+	}
+	if res.Result.Krbpwdhistorylength != nil {
+		data.HistoryLength = types.Int64Value(int64(*res.Result.Krbpwdhistorylength))
+	}
+	if res.Result.Krbpwdmindiffchars != nil {
+		data.MinClasses = types.Int64Value(int64(*res.Result.Krbpwdmindiffchars))
+	}
+	if res.Result.Krbpwdminlength != nil {
+		data.MinLength = types.Int64Value(int64(*res.Result.Krbpwdminlength))
+	}
+	if res.Result.Krbpwdmaxfailure != nil {
+		data.MaxFailures = types.Int64Value(int64(*res.Result.Krbpwdmaxfailure))
+	}
+	if res.Result.Krbpwdfailurecountinterval != nil {
+		data.FailureInterval = types.Int64Value(int64(*res.Result.Krbpwdfailurecountinterval))
+	}
+	if res.Result.Krbpwdlockoutduration != nil {
+		data.LockoutDuration = types.Int64Value(int64(*res.Result.Krbpwdlockoutduration))
+	}
+	if res.Result.Ipapwdmaxrepeat != nil {
+		data.MaxRepeat = types.Int64Value(int64(*res.Result.Ipapwdmaxrepeat))
+	}
+	if res.Result.Ipapwdmaxsequence != nil {
+		data.MaxSequence = types.Int64Value(int64(*res.Result.Ipapwdmaxsequence))
+	}
+	if res.Result.Ipapwddictcheck != nil {
+		data.DictionaryCheck = types.BoolValue(*res.Result.Ipapwddictcheck)
+	}
+	if res.Result.Ipapwdusercheck != nil {
+		data.UserCheck = types.BoolValue(*res.Result.Ipapwdusercheck)
+	}
+	if res.Result.Passwordgracelimit != nil {
+		data.GraceLoginLimit = types.Int64Value(int64(*res.Result.Passwordgracelimit))
+	}
+	data.Id = types.StringValue(name)
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa password policy %s", name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
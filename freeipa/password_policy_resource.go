@@ -0,0 +1,589 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// The global password policy always exists and cannot be created or deleted, it is only modified.
+const globalPasswordPolicyName = "global_policy"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PasswordPolicyResource{}
+var _ resource.ResourceWithImportState = &PasswordPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &PasswordPolicyResource{}
+
+func NewPasswordPolicyResource() resource.Resource {
+	return &PasswordPolicyResource{}
+}
+
+// PasswordPolicyResource defines the resource implementation.
+type PasswordPolicyResource struct {
+	client *ipa.Client
+}
+
+// PasswordPolicyResourceModel describes the resource data model.
+type PasswordPolicyResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Priority        types.Int64  `tfsdk:"priority"`
+	MaxLifetime     types.Int64  `tfsdk:"max_lifetime"`
+	MinLifetime     types.Int64  `tfsdk:"min_lifetime"`
+	HistoryLength   types.Int64  `tfsdk:"history_length"`
+	MinClasses      types.Int64  `tfsdk:"min_classes"`
+	MinLength       types.Int64  `tfsdk:"min_length"`
+	MaxFailures     types.Int64  `tfsdk:"max_failures"`
+	FailureInterval types.Int64  `tfsdk:"failure_interval"`
+	LockoutDuration types.Int64  `tfsdk:"lockout_duration"`
+	MaxRepeat       types.Int64  `tfsdk:"max_repeat"`
+	MaxSequence     types.Int64  `tfsdk:"max_sequence"`
+	DictionaryCheck types.Bool   `tfsdk:"dictionary_check"`
+	UserCheck       types.Bool   `tfsdk:"user_check"`
+	GraceLoginLimit types.Int64  `tfsdk:"grace_login_limit"`
+}
+
+func (r *PasswordPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy"
+}
+
+func (r *PasswordPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Password policy resource.\nUse the name `global_policy` to manage the global password policy: it is only modified, it is never created nor deleted (destroying the resource only removes it from the state).\nRemoving an attribute from the configuration stops managing it, the value in FreeIPA is left unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the group the password policy applies to, or `global_policy`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the policy (higher number means lower priority). Required for group policies, must not be set for the `global_policy`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Maximum password lifetime (in days)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Minimum password lifetime (in hours)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"history_length": schema.Int64Attribute{
+				MarkdownDescription: "Password history size",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_classes": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of character classes",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 5),
+				},
+			},
+			"min_length": schema.Int64Attribute{
+				MarkdownDescription: "Minimum length of password",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_failures": schema.Int64Attribute{
+				MarkdownDescription: "Consecutive failures before lockout",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"failure_interval": schema.Int64Attribute{
+				MarkdownDescription: "Period after which failure count will be reset (in seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"lockout_duration": schema.Int64Attribute{
+				MarkdownDescription: "Period for which lockout is enforced (in seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_repeat": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of same consecutive characters",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_sequence": schema.Int64Attribute{
+				MarkdownDescription: "The maximum length of monotonic character sequences (abcd)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"dictionary_check": schema.BoolAttribute{
+				MarkdownDescription: "Check if the password is a dictionary word",
+				Optional:            true,
+			},
+			"user_check": schema.BoolAttribute{
+				MarkdownDescription: "Check if the password contains the username",
+				Optional:            true,
+			},
+			"grace_login_limit": schema.Int64Attribute{
+				MarkdownDescription: "Number of LDAP authentications allowed after expiration",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *PasswordPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PasswordPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name or the priority may only be known at apply time.
+	if data.Name.IsUnknown() || data.Priority.IsUnknown() {
+		return
+	}
+	if data.Name.ValueString() == globalPasswordPolicyName {
+		if !data.Priority.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("priority"), "Invalid Attribute Configuration", "The priority cannot be set on the global password policy")
+		}
+	} else if data.Priority.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("priority"), "Missing Attribute Configuration", fmt.Sprintf("The priority is required for the password policy of group %s", data.Name.ValueString()))
+	}
+}
+
+func (r *PasswordPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PasswordPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if name == globalPasswordPolicyName {
+		optArgs := ipa.PwpolicyModOptionalArgs{
+			Cn: &name,
+		}
+		if !data.MaxLifetime.IsNull() {
+			v := int(data.MaxLifetime.ValueInt64())
+			optArgs.Krbmaxpwdlife = &v
+		}
+		if !data.MinLifetime.IsNull() {
+			v := int(data.MinLifetime.ValueInt64())
+			optArgs.Krbminpwdlife = &v
+		}
+		if !data.HistoryLength.IsNull() {
+			v := int(data.HistoryLength.ValueInt64())
+			optArgs.Krbpwdhistorylength = &v
+		}
+		if !data.MinClasses.IsNull() {
+			v := int(data.MinClasses.ValueInt64())
+			optArgs.Krbpwdmindiffchars = &v
+		}
+		if !data.MinLength.IsNull() {
+			v := int(data.MinLength.ValueInt64())
+			optArgs.Krbpwdminlength = &v
+		}
+		if !data.MaxFailures.IsNull() {
+			v := int(data.MaxFailures.ValueInt64())
+			optArgs.Krbpwdmaxfailure = &v
+		}
+		if !data.FailureInterval.IsNull() {
+			v := int(data.FailureInterval.ValueInt64())
+			optArgs.Krbpwdfailurecountinterval = &v
+		}
+		if !data.LockoutDuration.IsNull() {
+			v := int(data.LockoutDuration.ValueInt64())
+			optArgs.Krbpwdlockoutduration = &v
+		}
+		if !data.MaxRepeat.IsNull() {
+			v := int(data.MaxRepeat.ValueInt64())
+			optArgs.Ipapwdmaxrepeat = &v
+		}
+		if !data.MaxSequence.IsNull() {
+			v := int(data.MaxSequence.ValueInt64())
+			optArgs.Ipapwdmaxsequence = &v
+		}
+		if !data.DictionaryCheck.IsNull() {
+			optArgs.Ipapwddictcheck = data.DictionaryCheck.ValueBoolPointer()
+		}
+		if !data.UserCheck.IsNull() {
+			optArgs.Ipapwdusercheck = data.UserCheck.ValueBoolPointer()
+		}
+		if !data.GraceLoginLimit.IsNull() {
+			v := int(data.GraceLoginLimit.ValueInt64())
+			optArgs.Passwordgracelimit = &v
+		}
+		_, err := r.client.PwpolicyMod(&ipa.PwpolicyModArgs{}, &optArgs)
+		if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa global password policy: %s", err))
+			return
+		}
+	} else {
+		optArgs := ipa.PwpolicyAddOptionalArgs{
+			Cn: &name,
+		}
+		args := ipa.PwpolicyAddArgs{
+			Cospriority: int(data.Priority.ValueInt64()),
+		}
+		if !data.MaxLifetime.IsNull() {
+			v := int(data.MaxLifetime.ValueInt64())
+			optArgs.Krbmaxpwdlife = &v
+		}
+		if !data.MinLifetime.IsNull() {
+			v := int(data.MinLifetime.ValueInt64())
+			optArgs.Krbminpwdlife = &v
+		}
+		if !data.HistoryLength.IsNull() {
+			v := int(data.HistoryLength.ValueInt64())
+			optArgs.Krbpwdhistorylength = &v
+		}
+		if !data.MinClasses.IsNull() {
+			v := int(data.MinClasses.ValueInt64())
+			optArgs.Krbpwdmindiffchars = &v
+		}
+		if !data.MinLength.IsNull() {
+			v := int(data.MinLength.ValueInt64())
+			optArgs.Krbpwdminlength = &v
+		}
+		if !data.MaxFailures.IsNull() {
+			v := int(data.MaxFailures.ValueInt64())
+			optArgs.Krbpwdmaxfailure = &v
+		}
+		if !data.FailureInterval.IsNull() {
+			v := int(data.FailureInterval.ValueInt64())
+			optArgs.Krbpwdfailurecountinterval = &v
+		}
+		if !data.LockoutDuration.IsNull() {
+			v := int(data.LockoutDuration.ValueInt64())
+			optArgs.Krbpwdlockoutduration = &v
+		}
+		if !data.MaxRepeat.IsNull() {
+			v := int(data.MaxRepeat.ValueInt64())
+			optArgs.Ipapwdmaxrepeat = &v
+		}
+		if !data.MaxSequence.IsNull() {
+			v := int(data.MaxSequence.ValueInt64())
+			optArgs.Ipapwdmaxsequence = &v
+		}
+		if !data.DictionaryCheck.IsNull() {
+			optArgs.Ipapwddictcheck = data.DictionaryCheck.ValueBoolPointer()
+		}
+		if !data.UserCheck.IsNull() {
+			optArgs.Ipapwdusercheck = data.UserCheck.ValueBoolPointer()
+		}
+		if !data.GraceLoginLimit.IsNull() {
+			v := int(data.GraceLoginLimit.ValueInt64())
+			optArgs.Passwordgracelimit = &v
+		}
+		_, err := r.client.PwpolicyAdd(&args, &optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa password policy: %s", err))
+			return
+		}
+	}
+
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PasswordPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	name := data.Name.ValueString()
+	optArgs := ipa.PwpolicyShowOptionalArgs{
+		All: &all,
+		Cn:  &name,
+	}
+
+	res, err := r.client.PwpolicyShow(&ipa.PwpolicyShowArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Password policy not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa password policy: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa password policy %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa password policy %s", name))
+		return
+	}
+
+	if res.Result.Cospriority != nil && !data.Priority.IsNull() {
+		data.Priority = types.Int64Value(int64(*res.Result.Cospriority))
+	}
+	if res.Result.Krbmaxpwdlife != nil && !data.MaxLifetime.IsNull() {
+		data.MaxLifetime = types.Int64Value(int64(*res.Result.Krbmaxpwdlife))
+	}
+	if res.Result.Krbminpwdlife != nil && !data.MinLifetime.IsNull() {
+		data.MinLifetime = types.Int64Value(int64(*res.Result.Krbminpwdlife))
+	}
+	if res.Result.Krbpwdhistorylength != nil && !data.HistoryLength.IsNull() {
+		data.HistoryLength = types.Int64Value(int64(*res.Result.Krbpwdhistorylength))
+	}
+	if res.Result.Krbpwdmindiffchars != nil && !data.MinClasses.IsNull() {
+		data.MinClasses = types.Int64Value(int64(*res.Result.Krbpwdmindiffchars))
+	}
+	if res.Result.Krbpwdminlength != nil && !data.MinLength.IsNull() {
+		data.MinLength = types.Int64Value(int64(*res.Result.Krbpwdminlength))
+	}
+	if res.Result.Krbpwdmaxfailure != nil && !data.MaxFailures.IsNull() {
+		data.MaxFailures = types.Int64Value(int64(*res.Result.Krbpwdmaxfailure))
+	}
+	if res.Result.Krbpwdfailurecountinterval != nil && !data.FailureInterval.IsNull() {
+		data.FailureInterval = types.Int64Value(int64(*res.Result.Krbpwdfailurecountinterval))
+	}
+	if res.Result.Krbpwdlockoutduration != nil && !data.LockoutDuration.IsNull() {
+		data.LockoutDuration = types.Int64Value(int64(*res.Result.Krbpwdlockoutduration))
+	}
+	if res.Result.Ipapwdmaxrepeat != nil && !data.MaxRepeat.IsNull() {
+		data.MaxRepeat = types.Int64Value(int64(*res.Result.Ipapwdmaxrepeat))
+	}
+	if res.Result.Ipapwdmaxsequence != nil && !data.MaxSequence.IsNull() {
+		data.MaxSequence = types.Int64Value(int64(*res.Result.Ipapwdmaxsequence))
+	}
+	if res.Result.Ipapwddictcheck != nil && !data.DictionaryCheck.IsNull() {
+		data.DictionaryCheck = types.BoolValue(*res.Result.Ipapwddictcheck)
+	}
+	if res.Result.Ipapwdusercheck != nil && !data.UserCheck.IsNull() {
+		data.UserCheck = types.BoolValue(*res.Result.Ipapwdusercheck)
+	}
+	if res.Result.Passwordgracelimit != nil && !data.GraceLoginLimit.IsNull() {
+		data.GraceLoginLimit = types.Int64Value(int64(*res.Result.Passwordgracelimit))
+	}
+
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PasswordPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PasswordPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	optArgs := ipa.PwpolicyModOptionalArgs{
+		Cn: &name,
+	}
+
+	var hasChange = false
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa password policy %s from plan = %v", name, data))
+	if !data.Priority.Equal(state.Priority) {
+		v := int(data.Priority.ValueInt64())
+		optArgs.Cospriority = &v
+		hasChange = true
+	}
+	if !data.MaxLifetime.Equal(state.MaxLifetime) && !data.MaxLifetime.IsNull() {
+		v := int(data.MaxLifetime.ValueInt64())
+		optArgs.Krbmaxpwdlife = &v
+		hasChange = true
+	}
+	if !data.MinLifetime.Equal(state.MinLifetime) && !data.MinLifetime.IsNull() {
+		v := int(data.MinLifetime.ValueInt64())
+		optArgs.Krbminpwdlife = &v
+		hasChange = true
+	}
+	if !data.HistoryLength.Equal(state.HistoryLength) && !data.HistoryLength.IsNull() {
+		v := int(data.HistoryLength.ValueInt64())
+		optArgs.Krbpwdhistorylength = &v
+		hasChange = true
+	}
+	if !data.MinClasses.Equal(state.MinClasses) && !data.MinClasses.IsNull() {
+		v := int(data.MinClasses.ValueInt64())
+		optArgs.Krbpwdmindiffchars = &v
+		hasChange = true
+	}
+	if !data.MinLength.Equal(state.MinLength) && !data.MinLength.IsNull() {
+		v := int(data.MinLength.ValueInt64())
+		optArgs.Krbpwdminlength = &v
+		hasChange = true
+	}
+	if !data.MaxFailures.Equal(state.MaxFailures) && !data.MaxFailures.IsNull() {
+		v := int(data.MaxFailures.ValueInt64())
+		optArgs.Krbpwdmaxfailure = &v
+		hasChange = true
+	}
+	if !data.FailureInterval.Equal(state.FailureInterval) && !data.FailureInterval.IsNull() {
+		v := int(data.FailureInterval.ValueInt64())
+		optArgs.Krbpwdfailurecountinterval = &v
+		hasChange = true
+	}
+	if !data.LockoutDuration.Equal(state.LockoutDuration) && !data.LockoutDuration.IsNull() {
+		v := int(data.LockoutDuration.ValueInt64())
+		optArgs.Krbpwdlockoutduration = &v
+		hasChange = true
+	}
+	if !data.MaxRepeat.Equal(state.MaxRepeat) && !data.MaxRepeat.IsNull() {
+		v := int(data.MaxRepeat.ValueInt64())
+		optArgs.Ipapwdmaxrepeat = &v
+		hasChange = true
+	}
+	if !data.MaxSequence.Equal(state.MaxSequence) && !data.MaxSequence.IsNull() {
+		v := int(data.MaxSequence.ValueInt64())
+		optArgs.Ipapwdmaxsequence = &v
+		hasChange = true
+	}
+	if !data.DictionaryCheck.Equal(state.DictionaryCheck) && !data.DictionaryCheck.IsNull() {
+		optArgs.Ipapwddictcheck = data.DictionaryCheck.ValueBoolPointer()
+		hasChange = true
+	}
+	if !data.UserCheck.Equal(state.UserCheck) && !data.UserCheck.IsNull() {
+		optArgs.Ipapwdusercheck = data.UserCheck.ValueBoolPointer()
+		hasChange = true
+	}
+	if !data.GraceLoginLimit.Equal(state.GraceLoginLimit) && !data.GraceLoginLimit.IsNull() {
+		v := int(data.GraceLoginLimit.ValueInt64())
+		optArgs.Passwordgracelimit = &v
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.PwpolicyMod(&ipa.PwpolicyModArgs{}, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa password policy %s: %s", name, err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PasswordPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.ValueString() == globalPasswordPolicyName {
+		tflog.Debug(ctx, "[DEBUG] The global password policy cannot be deleted, removing it from the state only")
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa password policy Id %s", data.Id.ValueString()))
+	args := ipa.PwpolicyDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.PwpolicyDel(&args, &ipa.PwpolicyDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Password policy %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *PasswordPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,148 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAPasswordPolicy_group(t *testing.T) {
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group-0\"",
+	}
+	testPolicy := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_group.group-0.name",
+		"priority":   "10",
+		"min_length": "12",
+	}
+	testPolicyModified := map[string]string{
+		"index":            "0",
+		"name":             "freeipa_group.group-0.name",
+		"priority":         "20",
+		"min_length":       "16",
+		"min_classes":      "3",
+		"history_length":   "5",
+		"max_failures":     "5",
+		"lockout_duration": "600",
+	}
+	testPolicyDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_password_policy.password-policy-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAPasswordPolicy_resource(testPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_password_policy.password-policy-0", "name", "testacc-group-0"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.password-policy-0", "priority", "10"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.password-policy-0", "min_length", "12"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAPasswordPolicy_resource(testPolicyModified) + testAccFreeIPAPasswordPolicy_datasource(testPolicyDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_password_policy.password-policy-0", "priority", "20"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.password-policy-0", "min_length", "16"),
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.password-policy-0", "priority", "20"),
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.password-policy-0", "min_classes", "3"),
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.password-policy-0", "history_length", "5"),
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.password-policy-0", "max_failures", "5"),
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.password-policy-0", "lockout_duration", "600"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAPasswordPolicy_resource(testPolicyModified) + testAccFreeIPAPasswordPolicy_datasource(testPolicyDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAPasswordPolicy_global(t *testing.T) {
+	testPolicy := map[string]string{
+		"index":      "0",
+		"name":       "\"global_policy\"",
+		"min_length": "8",
+	}
+	testPolicyDS := map[string]string{
+		"index": "0",
+		"name":  "\"global_policy\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPasswordPolicy_resource(testPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_password_policy.password-policy-0", "name", "global_policy"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.password-policy-0", "min_length", "8"),
+					resource.TestCheckNoResourceAttr("freeipa_password_policy.password-policy-0", "priority"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPasswordPolicy_resource(testPolicy) + testAccFreeIPAPasswordPolicy_datasource(testPolicyDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.password-policy-0", "min_length", "8"),
+					resource.TestCheckNoResourceAttr("data.freeipa_password_policy.password-policy-0", "priority"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPasswordPolicy_resource(testPolicy) + testAccFreeIPAPasswordPolicy_datasource(testPolicyDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAPasswordPolicy_priority(t *testing.T) {
+	testPolicyNoPriority := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-group-0\"",
+		"min_length": "12",
+	}
+	testGlobalPolicyPriority := map[string]string{
+		"index":    "0",
+		"name":     "\"global_policy\"",
+		"priority": "10",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPAPasswordPolicy_resource(testPolicyNoPriority),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("The priority is required"),
+			},
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPAPasswordPolicy_resource(testGlobalPolicyPriority),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("The priority cannot be set"),
+			},
+		},
+	})
+}
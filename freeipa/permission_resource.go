@@ -0,0 +1,594 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PermissionResource{}
+var _ resource.ResourceWithImportState = &PermissionResource{}
+
+func NewPermissionResource() resource.Resource {
+	return &PermissionResource{}
+}
+
+// PermissionResource defines the resource implementation.
+type PermissionResource struct {
+	client *ipa.Client
+}
+
+// PermissionResourceModel describes the resource data model.
+type PermissionResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Right             types.List   `tfsdk:"right"`
+	BindType          types.String `tfsdk:"bind_type"`
+	Subtree           types.String `tfsdk:"subtree"`
+	Attrs             types.List   `tfsdk:"attrs"`
+	ExtraTargetFilter types.List   `tfsdk:"extra_target_filter"`
+	RawFilter         types.List   `tfsdk:"raw_filter"`
+	MemberOf          types.List   `tfsdk:"memberof"`
+	Target            types.String `tfsdk:"target"`
+	TargetTo          types.String `tfsdk:"target_to"`
+	TargetFrom        types.String `tfsdk:"target_from"`
+	TargetGroup       types.String `tfsdk:"target_group"`
+	Type              types.String `tfsdk:"type"`
+}
+
+func (r *PermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+func (r *PermissionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("raw_filter"),
+			path.MatchRoot("type"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("raw_filter"),
+			path.MatchRoot("memberof"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("raw_filter"),
+			path.MatchRoot("target_group"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("raw_filter"),
+			path.MatchRoot("extra_target_filter"),
+		),
+	}
+}
+
+func (r *PermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Permission resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the permission",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"right": schema.ListAttribute{
+				MarkdownDescription: "Rights to grant (read, search, compare, write, add, delete, all)",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("read", "search", "compare", "write", "add", "delete", "all")),
+				},
+			},
+			"bind_type": schema.StringAttribute{
+				MarkdownDescription: "Bind rule type. Defaults to `permission`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("permission", "all", "anonymous", "self"),
+				},
+			},
+			"subtree": schema.StringAttribute{
+				MarkdownDescription: "Subtree to apply permissions to",
+				Optional:            true,
+			},
+			"attrs": schema.ListAttribute{
+				MarkdownDescription: "Attributes to which the permission applies",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"extra_target_filter": schema.ListAttribute{
+				MarkdownDescription: "Extra target filter",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"raw_filter": schema.ListAttribute{
+				MarkdownDescription: "All target filters, including those implied by type and memberof. Conflicts with `type`, `memberof`, `target_group` and `extra_target_filter`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"memberof": schema.ListAttribute{
+				MarkdownDescription: "Target members of a group (sets memberOf targetfilter)",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Optional DN to apply the permission to (must be in the subtree, but may not yet exist)",
+				Optional:            true,
+			},
+			"target_to": schema.StringAttribute{
+				MarkdownDescription: "Optional DN subtree where an entry can be moved to (must be in the subtree, but may not yet exist)",
+				Optional:            true,
+			},
+			"target_from": schema.StringAttribute{
+				MarkdownDescription: "Optional DN subtree from where an entry can be moved (must be in the subtree, but may not yet exist)",
+				Optional:            true,
+			},
+			"target_group": schema.StringAttribute{
+				MarkdownDescription: "User group to apply permissions to (sets target)",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of IPA object (sets subtree and objectClass targetfilter)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *PermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.PermissionAddOptionalArgs{}
+
+	args := ipa.PermissionAddArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Right.IsNull() {
+		var v []string
+		for _, value := range data.Right.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipapermright = &v
+	}
+	if !data.BindType.IsNull() {
+		optArgs.Ipapermbindruletype = data.BindType.ValueStringPointer()
+	}
+	if !data.Subtree.IsNull() {
+		optArgs.Ipapermlocation = data.Subtree.ValueStringPointer()
+	}
+	if !data.Attrs.IsNull() {
+		var v []string
+		for _, value := range data.Attrs.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Attrs = &v
+	}
+	if !data.ExtraTargetFilter.IsNull() {
+		var v []string
+		for _, value := range data.ExtraTargetFilter.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Extratargetfilter = &v
+	}
+	if !data.RawFilter.IsNull() {
+		var v []string
+		for _, value := range data.RawFilter.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipapermtargetfilter = &v
+	}
+	if !data.MemberOf.IsNull() {
+		var v []string
+		for _, value := range data.MemberOf.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Memberof = &v
+	}
+	if !data.Target.IsNull() {
+		optArgs.Ipapermtarget = data.Target.ValueStringPointer()
+	}
+	if !data.TargetTo.IsNull() {
+		optArgs.Ipapermtargetto = data.TargetTo.ValueStringPointer()
+	}
+	if !data.TargetFrom.IsNull() {
+		optArgs.Ipapermtargetfrom = data.TargetFrom.ValueStringPointer()
+	}
+	if !data.TargetGroup.IsNull() {
+		optArgs.Targetgroup = data.TargetGroup.ValueStringPointer()
+	}
+	if !data.Type.IsNull() {
+		optArgs.Type = data.Type.ValueStringPointer()
+	}
+
+	res, err := r.client.PermissionAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa permission: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Create freeipa permission returned %s", res.Result.String()))
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.PermissionShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.PermissionShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.PermissionShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Permission not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa permission: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa permission %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa permission %s", data.Name.ValueString()))
+		return
+	}
+
+	if !data.Right.IsNull() && res.Result.Ipapermright != nil {
+		var changedVals []string
+		for _, value := range data.Right.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if isStringListContainsCaseInsensistive(res.Result.Ipapermright, &val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.Right, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.BindType.IsNull() {
+		data.BindType = types.StringValue(res.Result.Ipapermbindruletype)
+	}
+	if res.Result.Ipapermlocation != nil && !data.Subtree.IsNull() {
+		data.Subtree = types.StringValue(*res.Result.Ipapermlocation)
+	}
+	if !data.Attrs.IsNull() && res.Result.Attrs != nil {
+		var changedVals []string
+		for _, value := range data.Attrs.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if isStringListContainsCaseInsensistive(res.Result.Attrs, &val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.Attrs, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.ExtraTargetFilter.IsNull() && res.Result.Extratargetfilter != nil {
+		var changedVals []string
+		for _, value := range data.ExtraTargetFilter.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if isStringListContainsCaseInsensistive(res.Result.Extratargetfilter, &val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.ExtraTargetFilter, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.RawFilter.IsNull() && res.Result.Ipapermtargetfilter != nil {
+		var changedVals []string
+		for _, value := range data.RawFilter.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if isStringListContainsCaseInsensistive(res.Result.Ipapermtargetfilter, &val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.RawFilter, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.MemberOf.IsNull() && res.Result.Memberof != nil {
+		var changedVals []string
+		for _, value := range data.MemberOf.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if isStringListContainsCaseInsensistive(res.Result.Memberof, &val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.MemberOf, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Ipapermtarget != nil && !data.Target.IsNull() {
+		data.Target = types.StringValue(*res.Result.Ipapermtarget)
+	}
+	if res.Result.Ipapermtargetto != nil && !data.TargetTo.IsNull() {
+		data.TargetTo = types.StringValue(*res.Result.Ipapermtargetto)
+	}
+	if res.Result.Ipapermtargetfrom != nil && !data.TargetFrom.IsNull() {
+		data.TargetFrom = types.StringValue(*res.Result.Ipapermtargetfrom)
+	}
+	if res.Result.Targetgroup != nil && !data.TargetGroup.IsNull() {
+		data.TargetGroup = types.StringValue(*res.Result.Targetgroup)
+	}
+	if res.Result.Type != nil && !data.Type.IsNull() {
+		data.Type = types.StringValue(*res.Result.Type)
+	}
+
+	data.Id = data.Name
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa permission %s", res.Result.Cn))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.PermissionModOptionalArgs{}
+
+	args := ipa.PermissionModArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	var hasChange = false
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa permission %s from plan = %v", data.Name.ValueString(), data))
+	if !data.Right.Equal(state.Right) {
+		v := []string{}
+		for _, value := range data.Right.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipapermright = &v
+		hasChange = true
+	}
+	if !data.BindType.Equal(state.BindType) {
+		if data.BindType.ValueStringPointer() != nil {
+			optArgs.Ipapermbindruletype = data.BindType.ValueStringPointer()
+		} else {
+			v := "permission"
+			optArgs.Ipapermbindruletype = &v
+		}
+		hasChange = true
+	}
+	if !data.Subtree.Equal(state.Subtree) {
+		if data.Subtree.ValueStringPointer() != nil {
+			optArgs.Ipapermlocation = data.Subtree.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Ipapermlocation = &v
+		}
+		hasChange = true
+	}
+	if !data.Attrs.Equal(state.Attrs) {
+		v := []string{}
+		for _, value := range data.Attrs.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Attrs = &v
+		hasChange = true
+	}
+	if !data.ExtraTargetFilter.Equal(state.ExtraTargetFilter) {
+		v := []string{}
+		for _, value := range data.ExtraTargetFilter.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Extratargetfilter = &v
+		hasChange = true
+	}
+	if !data.RawFilter.Equal(state.RawFilter) {
+		v := []string{}
+		for _, value := range data.RawFilter.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipapermtargetfilter = &v
+		hasChange = true
+	}
+	if !data.MemberOf.Equal(state.MemberOf) {
+		v := []string{}
+		for _, value := range data.MemberOf.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Memberof = &v
+		hasChange = true
+	}
+	if !data.Target.Equal(state.Target) {
+		if data.Target.ValueStringPointer() != nil {
+			optArgs.Ipapermtarget = data.Target.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Ipapermtarget = &v
+		}
+		hasChange = true
+	}
+	if !data.TargetTo.Equal(state.TargetTo) {
+		if data.TargetTo.ValueStringPointer() != nil {
+			optArgs.Ipapermtargetto = data.TargetTo.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Ipapermtargetto = &v
+		}
+		hasChange = true
+	}
+	if !data.TargetFrom.Equal(state.TargetFrom) {
+		if data.TargetFrom.ValueStringPointer() != nil {
+			optArgs.Ipapermtargetfrom = data.TargetFrom.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Ipapermtargetfrom = &v
+		}
+		hasChange = true
+	}
+	if !data.TargetGroup.Equal(state.TargetGroup) {
+		if data.TargetGroup.ValueStringPointer() != nil {
+			optArgs.Targetgroup = data.TargetGroup.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Targetgroup = &v
+		}
+		hasChange = true
+	}
+	if !data.Type.Equal(state.Type) {
+		if data.Type.ValueStringPointer() != nil {
+			optArgs.Type = data.Type.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Type = &v
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.PermissionMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa permission %s: %s", data.Name.ValueString(), err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa permission Id %s", data.Id.ValueString()))
+	args := ipa.PermissionDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.PermissionDel(&args, &ipa.PermissionDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Permission %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *PermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,69 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAPermission_full(t *testing.T) {
+	testPermission := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-permission\"",
+		"right": "[\"read\"]",
+		"type":  "\"user\"",
+		"attrs": "[\"mail\"]",
+	}
+	testPermissionModified := map[string]string{
+		"index":     "0",
+		"name":      "\"testacc-permission\"",
+		"right":     "[\"read\", \"write\"]",
+		"type":      "\"user\"",
+		"attrs":     "[\"mail\", \"telephonenumber\"]",
+		"bind_type": "\"permission\"",
+	}
+	testPermissionDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_permission.permission-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPermission_resource(testPermission),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "name", "testacc-permission"),
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "right.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "attrs.0", "mail"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPermission_resource(testPermissionModified) + testAccFreeIPAPermission_datasource(testPermissionDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "right.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "attrs.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "bind_type", "permission"),
+					resource.TestCheckResourceAttr("data.freeipa_permission.permission-0", "type", "user"),
+					resource.TestCheckResourceAttr("data.freeipa_permission.permission-0", "right.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_permission.permission-0", "bind_type", "permission"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPermission_resource(testPermissionModified) + testAccFreeIPAPermission_datasource(testPermissionDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAPrivilegePermissionMembership_multiple(t *testing.T) {
+	testPermission0 := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-permission-0\"",
+		"right": "[\"read\"]",
+		"type":  "\"user\"",
+	}
+	testPermission1 := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-permission-1\"",
+		"right": "[\"read\"]",
+		"type":  "\"group\"",
+	}
+	testPrivilege := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-privilege\"",
+	}
+	testMembership := map[string]string{
+		"index":       "0",
+		"name":        "freeipa_privilege.privilege-0.name",
+		"permissions": "[freeipa_permission.permission-0.name]",
+		"identifier":  "\"permissions-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":       "0",
+		"name":        "freeipa_privilege.privilege-0.name",
+		"permissions": "[freeipa_permission.permission-0.name, freeipa_permission.permission-1.name]",
+		"identifier":  "\"permissions-0\"",
+	}
+	testPrivilegeDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_privilege.privilege-0.name",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPAPermission_resource(testPermission0) + testAccFreeIPAPermission_resource(testPermission1) + testAccFreeIPAPrivilege_resource(testPrivilege)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPAPrivilegePermissionMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "permissions.0", "testacc-permission-0"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAPrivilegePermissionMembership_resource(testMembershipModified) + testAccFreeIPAPrivilege_datasource(testPrivilegeDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_privilege.privilege-0", "memberof_permission.#", "2"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAPrivilegePermissionMembership_resource(testMembershipModified) + testAccFreeIPAPrivilege_datasource(testPrivilegeDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
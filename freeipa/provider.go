@@ -24,6 +24,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -35,6 +36,7 @@ import (
 
 // Ensure freeipaProvider satisfies various provider interfaces.
 var _ provider.Provider = &freeipaProvider{}
+var _ provider.ProviderWithEphemeralResources = &freeipaProvider{}
 
 //var _ provider.ProviderWithFunctions = &freeipaProvider{}
 
@@ -203,6 +205,7 @@ func (p *freeipaProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 // Client creates a FreeIPA client scoped to the global API
@@ -263,6 +266,7 @@ func (p *freeipaProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewSudoRuleDenyCmdMembershipResource,
 		NewSudoRuleHostMembershipResource,
 		NewSudoRuleOptionResource,
+		NewSudoRuleOptionsResource,
 		NewSudoRuleRunAsGroupMembershipResource,
 		NewSudoRuleRunAsUserMembershipResource,
 		NewSudoRuleUserMembershipResource,
@@ -272,6 +276,59 @@ func (p *freeipaProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewHbacPolicyServiceMembershipResource,
 		NewAutomemberResource,
 		NewAutomemberConditionResource,
+		NewServiceResource,
+		NewServiceManagedByMembershipResource,
+		NewServiceRetrieveKeytabMembershipResource,
+		NewServiceCreateKeytabMembershipResource,
+		NewHostManagedByMembershipResource,
+		NewHostRetrieveKeytabMembershipResource,
+		NewHostCreateKeytabMembershipResource,
+		NewPermissionResource,
+		NewPrivilegeResource,
+		NewRoleResource,
+		NewPrivilegePermissionMembershipResource,
+		NewRolePrivilegeMembershipResource,
+		NewRoleMembershipResource,
+		NewPasswordPolicyResource,
+		NewKerberosTicketPolicyResource,
+		NewHbacServiceResource,
+		NewHbacServiceGroupResource,
+		NewHbacServiceGroupMembershipResource,
+		NewNetgroupResource,
+		NewNetgroupUserMembershipResource,
+		NewNetgroupHostMembershipResource,
+		NewNetgroupNetgroupMembershipResource,
+		NewAutomountLocationResource,
+		NewAutomountMapResource,
+		NewAutomountKeyResource,
+		NewIdViewResource,
+		NewIdViewHostMembershipResource,
+		NewIdOverrideUserResource,
+		NewIdOverrideGroupResource,
+		NewIdRangeResource,
+		NewTrustResource,
+		NewCertificateResource,
+		NewCertificateProfileResource,
+		NewCaaclResource,
+		NewCaaclUserMembershipResource,
+		NewCaaclHostMembershipResource,
+		NewCaaclServiceMembershipResource,
+		NewCaaclProfileMembershipResource,
+		NewCaaclCaMembershipResource,
+		NewCaResource,
+		NewCertmapRuleResource,
+		NewCertmapConfigResource,
+		NewUserCertmapdataResource,
+		NewSelinuxUsermapResource,
+		NewSelinuxUsermapUserMembershipResource,
+		NewSelinuxUsermapHostMembershipResource,
+		NewOtpTokenResource,
+		NewRadiusProxyResource,
+		NewIdpResource,
+		NewVaultResource,
+		NewVaultMembershipResource,
+		NewSysaccountResource,
+		NewSubidResource,
 	}
 }
 
@@ -286,6 +343,25 @@ func (p *freeipaProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewSudoCmdGroupDataSource,
 		NewSudoRuleDataSource,
 		NewHbacPolicyDataSource,
+		NewServiceDataSource,
+		NewPermissionDataSource,
+		NewPrivilegeDataSource,
+		NewRoleDataSource,
+		NewPasswordPolicyDataSource,
+		NewHbacServiceDataSource,
+		NewHbacServiceGroupDataSource,
+		NewHbacTestDataSource,
+		NewAutomountLocationDataSource,
+		NewIdRangesDataSource,
+		NewTrustDataSource,
+		NewTrustDomainsDataSource,
+		NewCaDataSource,
+	}
+}
+
+func (p *freeipaProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewVaultSecretEphemeralResource,
 	}
 }
 
@@ -0,0 +1,376 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RadiusProxyResource{}
+var _ resource.ResourceWithImportState = &RadiusProxyResource{}
+
+func NewRadiusProxyResource() resource.Resource {
+	return &RadiusProxyResource{}
+}
+
+// RadiusProxyResource defines the resource implementation.
+type RadiusProxyResource struct {
+	client *ipa.Client
+}
+
+// RadiusProxyResourceModel describes the resource data model.
+type RadiusProxyResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Servers         types.List   `tfsdk:"servers"`
+	SecretWo        types.String `tfsdk:"secret_wo"`
+	SecretWoVersion types.Int64  `tfsdk:"secret_wo_version"`
+	Timeout         types.Int64  `tfsdk:"timeout"`
+	Retries         types.Int64  `tfsdk:"retries"`
+	UserAttribute   types.String `tfsdk:"user_attribute"`
+}
+
+func (r *RadiusProxyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_radius_proxy"
+}
+
+func (r *RadiusProxyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA RADIUS proxy server resource.\nThe name of the proxy can be referenced by the `radius_proxy_config` attribute of the `freeipa_user` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "RADIUS proxy server name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "RADIUS proxy server description",
+				Optional:            true,
+			},
+			"servers": schema.ListAttribute{
+				MarkdownDescription: "RADIUS servers, as hostname or IP address with an optional port (ie: `radius.example.lan:1812`)",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Shared secret of the RADIUS server. This attribute is write-only and never stored in the state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the shared secret. Change it to update the RADIUS proxy with a new `secret_wo`.",
+				Optional:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Total time in seconds to wait for a response of the RADIUS server",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times to retry the authentication",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"user_attribute": schema.StringAttribute{
+				MarkdownDescription: "Attribute of the user entry sent to the RADIUS server as the username (ie: `mail`)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *RadiusProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RadiusProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config RadiusProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.RadiusproxyAddOptionalArgs{
+		Ipatokenradiussecret: config.SecretWo.ValueStringPointer(),
+	}
+
+	var servers []string
+	for _, value := range data.Servers.Elements() {
+		val, _ := strconv.Unquote(value.String())
+		servers = append(servers, val)
+	}
+	args := ipa.RadiusproxyAddArgs{
+		Cn:                   data.Name.ValueString(),
+		Ipatokenradiusserver: servers,
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.Timeout.IsNull() {
+		v := int(data.Timeout.ValueInt64())
+		optArgs.Ipatokenradiustimeout = &v
+	}
+	if !data.Retries.IsNull() {
+		v := int(data.Retries.ValueInt64())
+		optArgs.Ipatokenradiusretries = &v
+	}
+	if !data.UserAttribute.IsNull() {
+		optArgs.Ipatokenusermapattribute = data.UserAttribute.ValueStringPointer()
+	}
+
+	_, err := r.client.RadiusproxyAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa radius proxy: %s", err))
+		return
+	}
+
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RadiusProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RadiusProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.RadiusproxyShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.RadiusproxyShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.RadiusproxyShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Radius proxy not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa radius proxy: %s", err))
+			return
+		}
+	}
+
+	data.Name = types.StringValue(res.Result.Cn)
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipatokenradiusserver != nil {
+		var diag diag.Diagnostics
+		data.Servers, diag = types.ListValueFrom(ctx, types.StringType, res.Result.Ipatokenradiusserver)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Ipatokenradiustimeout != nil && !data.Timeout.IsNull() {
+		data.Timeout = types.Int64Value(int64(*res.Result.Ipatokenradiustimeout))
+	}
+	if res.Result.Ipatokenradiusretries != nil && !data.Retries.IsNull() {
+		data.Retries = types.Int64Value(int64(*res.Result.Ipatokenradiusretries))
+	}
+	if res.Result.Ipatokenusermapattribute != nil && !data.UserAttribute.IsNull() {
+		data.UserAttribute = types.StringValue(*res.Result.Ipatokenusermapattribute)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RadiusProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state, config RadiusProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.RadiusproxyModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.RadiusproxyModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Servers.Equal(state.Servers) {
+		v := []string{}
+		for _, value := range data.Servers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipatokenradiusserver = &v
+		hasChange = true
+	}
+	// The secret is never read back, it is only sent when its version changes.
+	if !data.SecretWoVersion.Equal(state.SecretWoVersion) {
+		optArgs.Ipatokenradiussecret = config.SecretWo.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Timeout.Equal(state.Timeout) && !data.Timeout.IsNull() {
+		v := int(data.Timeout.ValueInt64())
+		optArgs.Ipatokenradiustimeout = &v
+		hasChange = true
+	}
+	if !data.Retries.Equal(state.Retries) && !data.Retries.IsNull() {
+		v := int(data.Retries.ValueInt64())
+		optArgs.Ipatokenradiusretries = &v
+		hasChange = true
+	}
+	if !data.UserAttribute.Equal(state.UserAttribute) {
+		if data.UserAttribute.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Ipatokenusermapattribute = &v
+		} else {
+			optArgs.Ipatokenusermapattribute = data.UserAttribute.ValueStringPointer()
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.RadiusproxyMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa radius proxy: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RadiusProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RadiusProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.RadiusproxyDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.RadiusproxyDel(&args, &ipa.RadiusproxyDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa radius proxy: %s", err))
+		return
+	}
+}
+
+func (r *RadiusProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	all := true
+	res, err := r.client.RadiusproxyShow(&ipa.RadiusproxyShowArgs{Cn: req.ID}, &ipa.RadiusproxyShowOptionalArgs{All: &all})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa radius proxy: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), *res.Result.Description)...)
+	}
+	if res.Result.Ipatokenradiustimeout != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(*res.Result.Ipatokenradiustimeout))...)
+	}
+	if res.Result.Ipatokenradiusretries != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("retries"), int64(*res.Result.Ipatokenradiusretries))...)
+	}
+	if res.Result.Ipatokenusermapattribute != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_attribute"), *res.Result.Ipatokenusermapattribute)...)
+	}
+}
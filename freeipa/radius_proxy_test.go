@@ -0,0 +1,81 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPARadiusProxy_full(t *testing.T) {
+	testRadiusProxy := map[string]string{
+		"index":     "0",
+		"name":      "\"testacc-radius-proxy\"",
+		"servers":   "[\"radius-1.testacc.ipatest.lan:1812\"]",
+		"secret_wo": "\"Secret123\"",
+	}
+	testRadiusProxyModified := map[string]string{
+		"index":             "0",
+		"name":              "\"testacc-radius-proxy\"",
+		"servers":           "[\"radius-1.testacc.ipatest.lan:1812\", \"radius-2.testacc.ipatest.lan:1812\"]",
+		"secret_wo":         "\"Secret456\"",
+		"secret_wo_version": "2",
+		"description":       "\"RADIUS proxy for acceptance tests\"",
+		"timeout":           "10",
+		"retries":           "5",
+		"user_attribute":    "\"mail\"",
+	}
+	testUser := map[string]string{
+		"index":                 "0",
+		"login":                 "\"testacc-user-0\"",
+		"firstname":             "\"Test\"",
+		"lastname":              "\"User0\"",
+		"auth_type":             "[\"radius\"]",
+		"radius_proxy_config":   "freeipa_radius_proxy.radius-proxy-0.name",
+		"radius_proxy_username": "\"testacc-user-0@testacc.ipatest.lan\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARadiusProxy_resource(testRadiusProxy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "name", "testacc-radius-proxy"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "servers.#", "1"),
+					resource.TestCheckNoResourceAttr("freeipa_radius_proxy.radius-proxy-0", "secret_wo"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARadiusProxy_resource(testRadiusProxyModified) + testAccFreeIPAUser_resource(testUser),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_radius_proxy.radius-proxy-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "servers.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "description", "RADIUS proxy for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "timeout", "10"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "retries", "5"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "user_attribute", "mail"),
+					resource.TestCheckResourceAttr("freeipa_user.user-0", "radius_proxy_config", "testacc-radius-proxy"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARadiusProxy_resource(testRadiusProxyModified) + testAccFreeIPAUser_resource(testUser),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
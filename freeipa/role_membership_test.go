@@ -0,0 +1,102 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPARoleMembership_multiple(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group-0\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup-0\"",
+	}
+	testRole := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-role\"",
+	}
+	testUsersMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_role.role-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"groups":     "[freeipa_group.group-0.name]",
+		"identifier": "\"users-0\"",
+	}
+	testHostsMembership := map[string]string{
+		"index":      "1",
+		"name":       "freeipa_role.role-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"services":   "[freeipa_service.service-0.name]",
+		"identifier": "\"hosts-1\"",
+	}
+	testRoleDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_role.role-0.name",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPARole_resource(testRole)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPARoleMembership_resource(testUsersMembership) + testAccFreeIPARoleMembership_resource(testHostsMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "users.0", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "groups.0", "testacc-group-0"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-1", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-1", "hostgroups.0", "testacc-hostgroup-0"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-1", "services.#", "1"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPARoleMembership_resource(testUsersMembership) + testAccFreeIPARoleMembership_resource(testHostsMembership) + testAccFreeIPARole_datasource(testRoleDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "member_user.0", "testacc-user-0"),
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "member_group.0", "testacc-group-0"),
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "member_host.0", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "member_hostgroup.0", "testacc-hostgroup-0"),
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "member_service.#", "1"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPARoleMembership_resource(testUsersMembership) + testAccFreeIPARoleMembership_resource(testHostsMembership) + testAccFreeIPARole_datasource(testRoleDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
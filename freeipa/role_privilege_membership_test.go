@@ -0,0 +1,76 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPARolePrivilegeMembership_multiple(t *testing.T) {
+	testPrivilege0 := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-privilege-0\"",
+	}
+	testPrivilege1 := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-privilege-1\"",
+	}
+	testRole := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-role\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_role.role-0.name",
+		"privileges": "[freeipa_privilege.privilege-0.name]",
+		"identifier": "\"privileges-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_role.role-0.name",
+		"privileges": "[freeipa_privilege.privilege-1.name]",
+		"identifier": "\"privileges-0\"",
+	}
+	testRoleDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_role.role-0.name",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilege0) + testAccFreeIPAPrivilege_resource(testPrivilege1) + testAccFreeIPARole_resource(testRole)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPARolePrivilegeMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "privileges.0", "testacc-privilege-0"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPARolePrivilegeMembership_resource(testMembershipModified) + testAccFreeIPARole_datasource(testRoleDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "privileges.0", "testacc-privilege-1"),
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "memberof_privilege.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "memberof_privilege.0", "testacc-privilege-1"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPARolePrivilegeMembership_resource(testMembershipModified) + testAccFreeIPARole_datasource(testRoleDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPARole_simple(t *testing.T) {
+	testRole := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-role\"",
+	}
+	testRoleModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-role\"",
+		"description": "\"A role for acceptance tests\"",
+	}
+	testRoleDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_role.role-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role.role-0", "name", "testacc-role"),
+					resource.TestCheckNoResourceAttr("freeipa_role.role-0", "description"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRoleModified) + testAccFreeIPARole_datasource(testRoleDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role.role-0", "description", "A role for acceptance tests"),
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "description", "A role for acceptance tests"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRoleModified) + testAccFreeIPARole_datasource(testRoleDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,197 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPASelinuxUsermap_categories(t *testing.T) {
+	testUsermap := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-selinux-usermap\"",
+		"selinux_user": "\"staff_u:s0-s0:c0.c1023\"",
+		"description":  "\"SELinux user map for acceptance tests\"",
+	}
+	testUsermapModified := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-selinux-usermap\"",
+		"selinux_user": "\"user_u:s0\"",
+		"description":  "\"SELinux user map for acceptance tests (modified)\"",
+		"enabled":      "false",
+		"usercategory": "\"all\"",
+		"hostcategory": "\"all\"",
+	}
+	testUsermapInvalid := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-selinux-usermap\"",
+		"selinux_user": "\"user_u:s0\"",
+		"hbac_rule":    "\"allow_all\"",
+		"usercategory": "\"all\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelinuxUsermap_resource(testUsermap),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "name", "testacc-selinux-usermap"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "selinux_user", "staff_u:s0-s0:c0.c1023"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "description", "SELinux user map for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelinuxUsermap_resource(testUsermapModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "selinux_user", "user_u:s0"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "description", "SELinux user map for acceptance tests (modified)"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "enabled", "false"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "usercategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "hostcategory", "all"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelinuxUsermap_resource(testUsermapModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPASelinuxUsermap_resource(testUsermapInvalid),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestAccFreeIPASelinuxUsermap_hbacRule(t *testing.T) {
+	testHbacPolicy := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-hbac-policy\"",
+		"description": "\"A hbac policy for acceptance tests\"",
+	}
+	testUsermap := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-selinux-usermap\"",
+		"selinux_user": "\"staff_u:s0-s0:c0.c1023\"",
+		"hbac_rule":    "freeipa_hbac_policy.hbacpolicy-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacPolicy_resource(testHbacPolicy) + testAccFreeIPASelinuxUsermap_resource(testUsermap),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "hbac_rule", "testacc-hbac-policy"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacPolicy_resource(testHbacPolicy) + testAccFreeIPASelinuxUsermap_resource(testUsermap),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPASelinuxUsermap_memberships(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-group-0\"",
+		"description": "\"User group test 0\"",
+	}
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-hostgroup-0\"",
+		"description": "\"Host group test 0\"",
+	}
+	testUsermap := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-selinux-usermap\"",
+		"selinux_user": "\"staff_u:s0-s0:c0.c1023\"",
+	}
+	testUserMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"identifier": "\"users\"",
+	}
+	testUserMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"groups":     "[freeipa_group.group-0.name]",
+		"identifier": "\"users\"",
+	}
+	testHostMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"identifier": "\"hosts\"",
+	}
+
+	base := testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPASelinuxUsermap_resource(testUsermap)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPASelinuxUsermapUserMembership_resource(testUserMembership) + testAccFreeIPASelinuxUsermapHostMembership_resource(testHostMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "users.0", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "hostgroups.0", "testacc-hostgroup-0"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPASelinuxUsermapUserMembership_resource(testUserMembershipModified) + testAccFreeIPASelinuxUsermapHostMembership_resource(testHostMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "groups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "groups.0", "testacc-group-0"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPASelinuxUsermapUserMembership_resource(testUserMembershipModified) + testAccFreeIPASelinuxUsermapHostMembership_resource(testHostMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
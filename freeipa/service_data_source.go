@@ -0,0 +1,217 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServiceDataSource{}
+var _ datasource.DataSourceWithConfigure = &ServiceDataSource{}
+
+func NewServiceDataSource() datasource.DataSource {
+	return &ServiceDataSource{}
+}
+
+// ServiceDataSource defines the data source implementation.
+type ServiceDataSource struct {
+	client *ipa.Client
+}
+
+// ServiceDataSourceModel describes the data source data model.
+type ServiceDataSourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	PrincipalAliases        types.List   `tfsdk:"principal_aliases"`
+	UserCertificates        types.List   `tfsdk:"user_certificates"`
+	KrbAuthIndicator        types.List   `tfsdk:"krb_auth_indicators"`
+	PacType                 types.List   `tfsdk:"pac_type"`
+	KrbPreAuth              types.Bool   `tfsdk:"krb_preauth"`
+	TrustedForDelegation    types.Bool   `tfsdk:"trusted_for_delegation"`
+	TrustedToAuthAsDelegate types.Bool   `tfsdk:"trusted_to_auth_as_delegate"`
+	HasKeytab               types.Bool   `tfsdk:"has_keytab"`
+	ManagedByHost           types.List   `tfsdk:"managedby_host"`
+}
+
+func (r *ServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (r *ServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Service data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Service principal name (e.g. 'HTTP/web.example.test')",
+				Required:            true,
+			},
+			"principal_aliases": schema.ListAttribute{
+				MarkdownDescription: "Principal names (canonical name and aliases) of the service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"user_certificates": schema.ListAttribute{
+				MarkdownDescription: "Base-64 encoded service certificate",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"krb_auth_indicators": schema.ListAttribute{
+				MarkdownDescription: "Defines a whitelist for Authentication Indicators. Use 'otp' to allow OTP-based 2FA authentications. Use 'radius' to allow RADIUS-based 2FA authentications. Other values may be used for custom configurations.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"pac_type": schema.ListAttribute{
+				MarkdownDescription: "Supported PAC types of the service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"krb_preauth": schema.BoolAttribute{
+				MarkdownDescription: "Pre-authentication is required for the service",
+				Computed:            true,
+			},
+			"trusted_for_delegation": schema.BoolAttribute{
+				MarkdownDescription: "Client credentials may be delegated to the service",
+				Computed:            true,
+			},
+			"trusted_to_auth_as_delegate": schema.BoolAttribute{
+				MarkdownDescription: "The service is allowed to authenticate on behalf of a client",
+				Computed:            true,
+			},
+			"has_keytab": schema.BoolAttribute{
+				MarkdownDescription: "Whether a keytab has been provisioned for this service",
+				Computed:            true,
+			},
+			"managedby_host": schema.ListAttribute{
+				MarkdownDescription: "List of hosts allowed to manage this service.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.ServiceShowArgs{
+		Krbcanonicalname: data.Name.ValueString(),
+	}
+	optArgs := ipa.ServiceShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.ServiceShow(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa service %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa service %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Krbprincipalname != nil {
+		var diag diag.Diagnostics
+		data.PrincipalAliases, diag = types.ListValueFrom(ctx, types.StringType, res.Result.Krbprincipalname)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Usercertificate != nil {
+		var resVals []string
+		for _, v := range *res.Result.Usercertificate {
+			resVals = append(resVals, v.(string))
+		}
+		var diag diag.Diagnostics
+		data.UserCertificates, diag = types.ListValueFrom(ctx, types.StringType, resVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Krbprincipalauthind != nil {
+		var diag diag.Diagnostics
+		data.KrbAuthIndicator, diag = types.ListValueFrom(ctx, types.StringType, res.Result.Krbprincipalauthind)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Ipakrbauthzdata != nil {
+		var diag diag.Diagnostics
+		data.PacType, diag = types.ListValueFrom(ctx, types.StringType, res.Result.Ipakrbauthzdata)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Ipakrbrequirespreauth != nil {
+		data.KrbPreAuth = types.BoolValue(*res.Result.Ipakrbrequirespreauth)
+	}
+	if res.Result.Ipakrbokasdelegate != nil {
+		data.TrustedForDelegation = types.BoolValue(*res.Result.Ipakrbokasdelegate)
+	}
+	if res.Result.Ipakrboktoauthasdelegate != nil {
+		data.TrustedToAuthAsDelegate = types.BoolValue(*res.Result.Ipakrboktoauthasdelegate)
+	}
+	if res.Result.HasKeytab != nil {
+		data.HasKeytab = types.BoolValue(*res.Result.HasKeytab)
+	}
+	if res.Result.ManagedbyHost != nil {
+		data.ManagedByHost, _ = types.ListValueFrom(ctx, types.StringType, res.Result.ManagedbyHost)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa service %s", res.Result.Krbcanonicalname))
+
+	data.Id = types.StringValue(data.Name.ValueString())
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,135 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAServiceManagedByMembership_multiple(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testMemberHost := map[string]string{
+		"index":      "1",
+		"name":       "\"testacc-host-2.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.66\"",
+	}
+	testMemberHost2 := map[string]string{
+		"index":      "2",
+		"name":       "\"testacc-host-3.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.67\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_service.service-0.name",
+		"hosts":      "[freeipa_host.host-1.name]",
+		"identifier": "\"managedby-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_service.service-0.name",
+		"hosts":      "[freeipa_host.host-1.name, freeipa_host.host-2.name]",
+		"identifier": "\"managedby-0\"",
+	}
+	testServiceDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_service.service-0.name",
+	}
+	hosts := testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHost_resource(testMemberHost) + testAccFreeIPAHost_resource(testMemberHost2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + hosts + testAccFreeIPAService_resource(testService) + testAccFreeIPAServiceManagedByMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service_managedby_membership.service-managedby-membership-0", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_service_managedby_membership.service-managedby-membership-0", "hosts.0", "testacc-host-2.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + hosts + testAccFreeIPAService_resource(testService) + testAccFreeIPAServiceManagedByMembership_resource(testMembershipModified) + testAccFreeIPAService_datasource(testServiceDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service_managedby_membership.service-managedby-membership-0", "hosts.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_service_managedby_membership.service-managedby-membership-0", "hosts.1", "testacc-host-3.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "managedby_host.#", "3"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + hosts + testAccFreeIPAService_resource(testService) + testAccFreeIPAServiceManagedByMembership_resource(testMembershipModified) + testAccFreeIPAService_datasource(testServiceDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAServiceManagedByMembership_single(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testMemberHost := map[string]string{
+		"index":      "1",
+		"name":       "\"testacc-host-2.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.66\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_service.service-0.name",
+		"host":  "freeipa_host.host-1.name",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHost_resource(testMemberHost) + testAccFreeIPAService_resource(testService)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPAServiceManagedByMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service_managedby_membership.service-managedby-membership-0", "host", "testacc-host-2.testacc.ipatest.lan"),
+					resource.TestCheckNoResourceAttr("freeipa_service_managedby_membership.service-managedby-membership-0", "hosts"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAServiceManagedByMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
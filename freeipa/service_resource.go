@@ -0,0 +1,440 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServiceResource{}
+var _ resource.ResourceWithImportState = &ServiceResource{}
+
+func NewServiceResource() resource.Resource {
+	return &ServiceResource{}
+}
+
+// ServiceResource defines the resource implementation.
+type ServiceResource struct {
+	client *ipa.Client
+}
+
+// ServiceResourceModel describes the resource data model.
+type ServiceResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	UserCertificates        types.List   `tfsdk:"user_certificates"`
+	KrbAuthIndicator        types.List   `tfsdk:"krb_auth_indicators"`
+	PacType                 types.List   `tfsdk:"pac_type"`
+	KrbPreAuth              types.Bool   `tfsdk:"krb_preauth"`
+	TrustedForDelegation    types.Bool   `tfsdk:"trusted_for_delegation"`
+	TrustedToAuthAsDelegate types.Bool   `tfsdk:"trusted_to_auth_as_delegate"`
+	Force                   types.Bool   `tfsdk:"force"`
+	SkipHostCheck           types.Bool   `tfsdk:"skip_host_check"`
+}
+
+func (r *ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (r *ServiceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Service resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Service principal name (e.g. 'HTTP/web.example.test'). The service must be bound to an existing host unless `force` or `skip_host_check` is set.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_certificates": schema.ListAttribute{
+				MarkdownDescription: "Base-64 encoded service certificate",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"krb_auth_indicators": schema.ListAttribute{
+				MarkdownDescription: "Defines a whitelist for Authentication Indicators. Use 'otp' to allow OTP-based 2FA authentications. Use 'radius' to allow RADIUS-based 2FA authentications. Other values may be used for custom configurations.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"pac_type": schema.ListAttribute{
+				MarkdownDescription: "Override default list of supported PAC types. Use 'NONE' to disable PAC support for this service, e.g. this might be necessary for NFS services. (allowed values: MS-PAC, PAD, NONE)",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("MS-PAC", "PAD", "NONE")),
+				},
+			},
+			"krb_preauth": schema.BoolAttribute{
+				MarkdownDescription: "Pre-authentication is required for the service",
+				Optional:            true,
+			},
+			"trusted_for_delegation": schema.BoolAttribute{
+				MarkdownDescription: "Client credentials may be delegated to the service",
+				Optional:            true,
+			},
+			"trusted_to_auth_as_delegate": schema.BoolAttribute{
+				MarkdownDescription: "The service is allowed to authenticate on behalf of a client",
+				Optional:            true,
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Force principal name even if host not in DNS",
+				Optional:            true,
+			},
+			"skip_host_check": schema.BoolAttribute{
+				MarkdownDescription: "Force service to be created even when host object does not exist to manage it",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.ServiceAddOptionalArgs{}
+
+	args := ipa.ServiceAddArgs{
+		Krbcanonicalname: data.Name.ValueString(),
+	}
+
+	if !data.UserCertificates.IsNull() {
+		var v []interface{}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Usercertificate = &v
+	}
+	if !data.KrbAuthIndicator.IsNull() {
+		var v []string
+		for _, value := range data.KrbAuthIndicator.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Krbprincipalauthind = &v
+	}
+	if !data.PacType.IsNull() {
+		var v []string
+		for _, value := range data.PacType.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipakrbauthzdata = &v
+	}
+	if !data.KrbPreAuth.IsNull() {
+		optArgs.Ipakrbrequirespreauth = data.KrbPreAuth.ValueBoolPointer()
+	}
+	if !data.TrustedForDelegation.IsNull() {
+		optArgs.Ipakrbokasdelegate = data.TrustedForDelegation.ValueBoolPointer()
+	}
+	if !data.TrustedToAuthAsDelegate.IsNull() {
+		optArgs.Ipakrboktoauthasdelegate = data.TrustedToAuthAsDelegate.ValueBoolPointer()
+	}
+	if !data.Force.IsNull() {
+		optArgs.Force = data.Force.ValueBoolPointer()
+	}
+	if !data.SkipHostCheck.IsNull() {
+		optArgs.SkipHostCheck = data.SkipHostCheck.ValueBoolPointer()
+	}
+
+	res, err := r.client.ServiceAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa service: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Create freeipa service returned %s", res.Result.String()))
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.ServiceShowArgs{
+		Krbcanonicalname: data.Name.ValueString(),
+	}
+	optArgs := ipa.ServiceShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.ServiceShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Service not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa service: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa service %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa service %s", data.Name.ValueString()))
+		return
+	}
+
+	if !data.UserCertificates.IsNull() && res.Result.Usercertificate != nil {
+		var changedVals, resVals []string
+		for _, v := range *res.Result.Usercertificate {
+			resVals = append(resVals, v.(string))
+		}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if slices.Contains(resVals, val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.UserCertificates, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.KrbAuthIndicator.IsNull() && res.Result.Krbprincipalauthind != nil {
+		var changedVals []string
+		for _, value := range data.KrbAuthIndicator.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if slices.Contains(*res.Result.Krbprincipalauthind, val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.KrbAuthIndicator, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.PacType.IsNull() && res.Result.Ipakrbauthzdata != nil {
+		var changedVals []string
+		for _, value := range data.PacType.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if slices.Contains(*res.Result.Ipakrbauthzdata, val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.PacType, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Ipakrbrequirespreauth != nil && !data.KrbPreAuth.IsNull() {
+		data.KrbPreAuth = types.BoolValue(*res.Result.Ipakrbrequirespreauth)
+	}
+	if res.Result.Ipakrbokasdelegate != nil && !data.TrustedForDelegation.IsNull() {
+		data.TrustedForDelegation = types.BoolValue(*res.Result.Ipakrbokasdelegate)
+	}
+	if res.Result.Ipakrboktoauthasdelegate != nil && !data.TrustedToAuthAsDelegate.IsNull() {
+		data.TrustedToAuthAsDelegate = types.BoolValue(*res.Result.Ipakrboktoauthasdelegate)
+	}
+
+	data.Id = data.Name
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa service %s", res.Result.Krbcanonicalname))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.ServiceModOptionalArgs{}
+
+	args := ipa.ServiceModArgs{
+		Krbcanonicalname: data.Name.ValueString(),
+	}
+
+	var hasChange = false
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa service %s from plan = %v", data.Name.ValueString(), data))
+	if !data.UserCertificates.Equal(state.UserCertificates) {
+		v := []interface{}{}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Usercertificate = &v
+		hasChange = true
+	}
+	if !data.KrbAuthIndicator.Equal(state.KrbAuthIndicator) {
+		v := []string{}
+		for _, value := range data.KrbAuthIndicator.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Krbprincipalauthind = &v
+		hasChange = true
+	}
+	if !data.PacType.Equal(state.PacType) {
+		v := []string{}
+		for _, value := range data.PacType.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Ipakrbauthzdata = &v
+		hasChange = true
+	}
+	if !data.KrbPreAuth.Equal(state.KrbPreAuth) {
+		if data.KrbPreAuth.ValueBoolPointer() != nil {
+			optArgs.Ipakrbrequirespreauth = data.KrbPreAuth.ValueBoolPointer()
+		} else {
+			v := true
+			optArgs.Ipakrbrequirespreauth = &v
+		}
+		hasChange = true
+	}
+	if !data.TrustedForDelegation.Equal(state.TrustedForDelegation) {
+		if data.TrustedForDelegation.ValueBoolPointer() != nil {
+			optArgs.Ipakrbokasdelegate = data.TrustedForDelegation.ValueBoolPointer()
+		} else {
+			v := false
+			optArgs.Ipakrbokasdelegate = &v
+		}
+		hasChange = true
+	}
+	if !data.TrustedToAuthAsDelegate.Equal(state.TrustedToAuthAsDelegate) {
+		if data.TrustedToAuthAsDelegate.ValueBoolPointer() != nil {
+			optArgs.Ipakrboktoauthasdelegate = data.TrustedToAuthAsDelegate.ValueBoolPointer()
+		} else {
+			v := false
+			optArgs.Ipakrboktoauthasdelegate = &v
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.ServiceMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa service %s: %s", data.Name.ValueString(), err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa service Id %s", data.Id.ValueString()))
+	args := ipa.ServiceDelArgs{
+		Krbcanonicalname: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.ServiceDel(&args, &ipa.ServiceDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Service %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,165 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAServiceRetrieveKeytabMembership_multiple(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group-0\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup-0\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_service.service-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"identifier": "\"retrieve-keytab-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_service.service-0.name",
+		"groups":     "[freeipa_group.group-0.name]",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"identifier": "\"retrieve-keytab-0\"",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAService_resource(testService)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + testAccFreeIPAServiceRetrieveKeytabMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-0", "users.0", "testacc-user-0"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAServiceRetrieveKeytabMembership_resource(testMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-0", "users"),
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-0", "groups.0", "testacc-group-0"),
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-0", "hostgroups.0", "testacc-hostgroup-0"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPAServiceRetrieveKeytabMembership_resource(testMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAServiceRetrieveKeytabMembership_single(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group-0\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup-0\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testMembershipUser := map[string]string{
+		"index": "0",
+		"name":  "freeipa_service.service-0.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+	testMembershipGroup := map[string]string{
+		"index": "1",
+		"name":  "freeipa_service.service-0.name",
+		"group": "freeipa_group.group-0.name",
+	}
+	testMembershipHost := map[string]string{
+		"index": "2",
+		"name":  "freeipa_service.service-0.name",
+		"host":  "freeipa_host.host-0.name",
+	}
+	testMembershipHostGroup := map[string]string{
+		"index":     "3",
+		"name":      "freeipa_service.service-0.name",
+		"hostgroup": "freeipa_hostgroup.hostgroup-0.name",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAService_resource(testService)
+	memberships := testAccFreeIPAServiceRetrieveKeytabMembership_resource(testMembershipUser) + testAccFreeIPAServiceRetrieveKeytabMembership_resource(testMembershipGroup) + testAccFreeIPAServiceRetrieveKeytabMembership_resource(testMembershipHost) + testAccFreeIPAServiceRetrieveKeytabMembership_resource(testMembershipHostGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base + memberships,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-0", "user", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-1", "group", "testacc-group-0"),
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-2", "host", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_service_retrieve_keytab_membership.service-retrieve-keytab-membership-3", "hostgroup", "testacc-hostgroup-0"),
+				),
+			},
+			{
+				Config: base + memberships,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAService_full(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testServiceModified := map[string]string{
+		"index":                  "0",
+		"name":                   "\"HTTP/${freeipa_host.host-0.name}\"",
+		"krb_auth_indicators":    "[\"otp\", \"radius\"]",
+		"pac_type":               "[\"MS-PAC\"]",
+		"krb_preauth":            "false",
+		"trusted_for_delegation": "true",
+	}
+	testServiceDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_service.service-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "name", "HTTP/testacc-host-1.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testServiceModified) + testAccFreeIPAService_datasource(testServiceDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "krb_auth_indicators.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "krb_auth_indicators.0", "otp"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "pac_type.0", "MS-PAC"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "krb_preauth", "false"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "trusted_for_delegation", "true"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "krb_auth_indicators.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "pac_type.0", "MS-PAC"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "krb_preauth", "false"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "trusted_for_delegation", "true"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "has_keytab", "false"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testServiceModified) + testAccFreeIPAService_datasource(testServiceDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "name", "HTTP/testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckNoResourceAttr("freeipa_service.service-0", "krb_auth_indicators"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAService_skip_host_check(t *testing.T) {
+	testService := map[string]string{
+		"index":           "0",
+		"name":            "\"postgres/testacc-db.testacc.ipatest.lan\"",
+		"force":           "true",
+		"skip_host_check": "true",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAService_resource(testService),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "name", "postgres/testacc-db.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAService_resource(testService),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
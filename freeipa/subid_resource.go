@@ -0,0 +1,313 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubidResource{}
+var _ resource.ResourceWithImportState = &SubidResource{}
+
+func NewSubidResource() resource.Resource {
+	return &SubidResource{}
+}
+
+// SubidResource defines the resource implementation.
+type SubidResource struct {
+	client *ipa.Client
+}
+
+// SubidResourceModel describes the resource data model.
+type SubidResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Owner        types.String `tfsdk:"owner"`
+	Description  types.String `tfsdk:"description"`
+	UniqueId     types.String `tfsdk:"unique_id"`
+	SubuidBase   types.Int64  `tfsdk:"subuid_base"`
+	SubuidLength types.Int64  `tfsdk:"subuid_length"`
+	SubgidBase   types.Int64  `tfsdk:"subgid_base"`
+	SubgidLength types.Int64  `tfsdk:"subgid_length"`
+}
+
+func (r *SubidResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subid"
+}
+
+func (r *SubidResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA subordinate ID resource.\nGenerates the subordinate user and group ID ranges of a user, used by rootless containers. A user can only have one subordinate ID range.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource, the login of the owner",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Login of the user owning the subordinate IDs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Subordinate ID description",
+				Optional:            true,
+			},
+			"unique_id": schema.StringAttribute{
+				MarkdownDescription: "Unique ID of the subordinate ID range",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subuid_base": schema.Int64Attribute{
+				MarkdownDescription: "First subordinate user ID allocated",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"subuid_length": schema.Int64Attribute{
+				MarkdownDescription: "Number of subordinate user IDs allocated",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"subgid_base": schema.Int64Attribute{
+				MarkdownDescription: "First subordinate group ID allocated",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"subgid_length": schema.Int64Attribute{
+				MarkdownDescription: "Number of subordinate group IDs allocated",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SubidResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubidResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubidResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.SubidGenerateOptionalArgs{
+		Ipaowner: data.Owner.ValueStringPointer(),
+	}
+	res, err := r.client.SubidGenerate(&ipa.SubidGenerateArgs{}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa subid: %s", err))
+		return
+	}
+
+	data.Id = data.Owner
+	setSubidComputedAttributes(&data, res.Result)
+
+	// The description can only be set once the range is generated.
+	if !data.Description.IsNull() {
+		_, err = r.client.SubidMod(&ipa.SubidModArgs{Ipauniqueid: res.Result.Ipauniqueid}, &ipa.SubidModOptionalArgs{Description: data.Description.ValueStringPointer()})
+		if err != nil {
+			// The range is already generated, it is saved in the state to be tracked and the error marks it as tainted.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa subid: %s", err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubidResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubidResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subid, err := findSubidByOwner(r.client, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa subid: %s", err))
+		return
+	}
+	if subid == nil {
+		tflog.Debug(ctx, "[DEBUG] Subid not found")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Owner = data.Id
+	if subid.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*subid.Description)
+	}
+	setSubidComputedAttributes(&data, *subid)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SubidResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SubidResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Description.Equal(state.Description) {
+		optArgs := ipa.SubidModOptionalArgs{}
+		if data.Description.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		_, err := r.client.SubidMod(&ipa.SubidModArgs{Ipauniqueid: state.UniqueId.ValueString()}, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa subid: %s", err))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubidResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubidResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.SubidDelArgs{
+		Ipauniqueid: []string{data.UniqueId.ValueString()},
+	}
+	_, err := r.client.SubidDel(&args, &ipa.SubidDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa subid: %s", err))
+		return
+	}
+}
+
+func (r *SubidResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	subid, err := findSubidByOwner(r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa subid: %s", err))
+		return
+	}
+	if subid == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("No subid found for the user %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), req.ID)...)
+	if subid.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), *subid.Description)...)
+	}
+}
+
+// findSubidByOwner returns the subordinate ID range of a user, nil when the user has none.
+func findSubidByOwner(client *ipa.Client, owner string) (*ipa.Subid, error) {
+	all := true
+	optArgs := ipa.SubidFindOptionalArgs{
+		All:      &all,
+		Ipaowner: &owner,
+	}
+	res, err := client.SubidFind("", &ipa.SubidFindArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(res.Result) == 0 {
+		return nil, nil
+	}
+	return &res.Result[0], nil
+}
+
+func setSubidComputedAttributes(data *SubidResourceModel, subid ipa.Subid) {
+	data.UniqueId = types.StringValue(subid.Ipauniqueid)
+	if subid.Ipasubuidnumber != nil {
+		data.SubuidBase = types.Int64Value(int64(*subid.Ipasubuidnumber))
+	}
+	if subid.Ipasubuidcount != nil {
+		data.SubuidLength = types.Int64Value(int64(*subid.Ipasubuidcount))
+	}
+	if subid.Ipasubgidnumber != nil {
+		data.SubgidBase = types.Int64Value(int64(*subid.Ipasubgidnumber))
+	}
+	if subid.Ipasubgidcount != nil {
+		data.SubgidLength = types.Int64Value(int64(*subid.Ipasubgidcount))
+	}
+}
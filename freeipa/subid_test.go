@@ -0,0 +1,77 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPASubid_full(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-subid\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"Subid\"",
+	}
+	testSubid := map[string]string{
+		"index": "0",
+		"owner": "freeipa_user.user-0.name",
+	}
+	testSubidModified := map[string]string{
+		"index":       "0",
+		"owner":       "freeipa_user.user-0.name",
+		"description": "\"Subordinate IDs for acceptance tests\"",
+	}
+	testDataSource := map[string]string{
+		"index": "0",
+		"name":  "freeipa_subid.subid-0.owner",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPASubid_resource(testSubid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_subid.subid-0", "id", "testacc-subid"),
+					resource.TestCheckResourceAttr("freeipa_subid.subid-0", "owner", "testacc-subid"),
+					resource.TestCheckResourceAttrSet("freeipa_subid.subid-0", "unique_id"),
+					resource.TestCheckResourceAttrSet("freeipa_subid.subid-0", "subuid_base"),
+					resource.TestCheckResourceAttr("freeipa_subid.subid-0", "subuid_length", "65536"),
+					resource.TestCheckResourceAttrSet("freeipa_subid.subid-0", "subgid_base"),
+					resource.TestCheckResourceAttr("freeipa_subid.subid-0", "subgid_length", "65536"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPASubid_resource(testSubidModified) + testAccFreeIPAUser_datasource(testDataSource),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_subid.subid-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_subid.subid-0", "description", "Subordinate IDs for acceptance tests"),
+					resource.TestCheckResourceAttr("data.freeipa_user.user-0", "subids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.freeipa_user.user-0", "subids.0.unique_id", "freeipa_subid.subid-0", "unique_id"),
+					resource.TestCheckResourceAttrPair("data.freeipa_user.user-0", "subids.0.subuid_base", "freeipa_subid.subid-0", "subuid_base"),
+					resource.TestCheckResourceAttrPair("data.freeipa_user.user-0", "subids.0.subgid_base", "freeipa_subid.subid-0", "subgid_base"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPASubid_resource(testSubidModified) + testAccFreeIPAUser_datasource(testDataSource),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -59,6 +59,10 @@ type SudoRuleDataSourceModel struct {
 	MemberDenySudoCmdGroup  types.List   `tfsdk:"member_deny_sudo_cmdgroup"`
 	RunAsUser               types.List   `tfsdk:"runasuser"`
 	RunAsGroup              types.List   `tfsdk:"runasgroup"`
+	ExternalUser            types.List   `tfsdk:"external_user"`
+	ExternalHost            types.List   `tfsdk:"external_host"`
+	ExternalRunAsUser       types.List   `tfsdk:"external_runasuser"`
+	ExternalRunAsGroup      types.List   `tfsdk:"external_runasgroup"`
 }
 
 func (r *SudoRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -166,6 +170,26 @@ func (r *SudoRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"external_user": schema.ListAttribute{
+				MarkdownDescription: "List of external users (not managed by FreeIPA) member of this sudo rule.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"external_host": schema.ListAttribute{
+				MarkdownDescription: "List of external hosts (not managed by FreeIPA) member of this sudo rule.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"external_runasuser": schema.ListAttribute{
+				MarkdownDescription: "List of external users (not managed by FreeIPA) authorised to be run as.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"external_runasgroup": schema.ListAttribute{
+				MarkdownDescription: "List of external groups (not managed by FreeIPA) authorised to be run as.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -269,6 +293,18 @@ func (r *SudoRuleDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if res.Result.IpasudorunasgroupGroup != nil {
 		data.RunAsGroup, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpasudorunasgroupGroup)
 	}
+	if res.Result.Externaluser != nil {
+		data.ExternalUser, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Externaluser)
+	}
+	if res.Result.Externalhost != nil {
+		data.ExternalHost, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Externalhost)
+	}
+	if res.Result.Ipasudorunasextuser != nil {
+		data.ExternalRunAsUser, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Ipasudorunasextuser)
+	}
+	if res.Result.Ipasudorunasextgroup != nil {
+		data.ExternalRunAsGroup, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Ipasudorunasextgroup)
+	}
 	if res.Result.Ipasudoopt != nil {
 		data.Option, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Ipasudoopt)
 	}
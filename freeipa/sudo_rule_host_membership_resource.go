@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	ipa "github.com/infra-monkey/go-freeipa/freeipa"
@@ -47,13 +49,14 @@ type SudoRuleHostMembershipResource struct {
 
 // SudoRuleHostMembershipResourceModel describes the resource data model.
 type SudoRuleHostMembershipResourceModel struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Host       types.String `tfsdk:"host"`
-	Hosts      types.List   `tfsdk:"hosts"`
-	HostGroup  types.String `tfsdk:"hostgroup"`
-	HostGroups types.List   `tfsdk:"hostgroups"`
-	Identifier types.String `tfsdk:"identifier"`
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Host          types.String `tfsdk:"host"`
+	Hosts         types.List   `tfsdk:"hosts"`
+	ExternalHosts types.List   `tfsdk:"external_hosts"`
+	HostGroup     types.String `tfsdk:"hostgroup"`
+	HostGroups    types.List   `tfsdk:"hostgroups"`
+	Identifier    types.String `tfsdk:"identifier"`
 }
 
 func (r *SudoRuleHostMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,6 +85,10 @@ func (r *SudoRuleHostMembershipResource) ConfigValidators(ctx context.Context) [
 			path.MatchRoot("hostgroup"),
 			path.MatchRoot("hostgroups"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("external_hosts"),
+		),
 	}
 }
 
@@ -131,8 +138,16 @@ func (r *SudoRuleHostMembershipResource) Schema(ctx context.Context, req resourc
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"external_hosts": schema.ListAttribute{
+				MarkdownDescription: "List of external hosts (not managed by FreeIPA) to add to the sudo rule. Hosts that exist in FreeIPA must be added with `hosts`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
 			"identifier": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier to differentiate multiple sudo rule host membership resources on the same sudo rule. Manadatory for using hosts/hostgroups configurations.",
+				MarkdownDescription: "Unique identifier to differentiate multiple sudo rule host membership resources on the same sudo rule. Manadatory for using hosts/hostgroups/external_hosts configurations.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -189,13 +204,18 @@ func (r *SudoRuleHostMembershipResource) Create(ctx context.Context, req resourc
 		optArgs.Hostgroup = &v
 		cmd_id = "srhg"
 	}
-	if !data.Hosts.IsNull() || !data.HostGroups.IsNull() {
-		if !data.Hosts.IsNull() {
+	if !data.Hosts.IsNull() || !data.HostGroups.IsNull() || !data.ExternalHosts.IsNull() {
+		// External hosts are added with the host option, FreeIPA stores the hosts it does not know as externalhost.
+		if !data.Hosts.IsNull() || !data.ExternalHosts.IsNull() {
 			var v []string
 			for _, value := range data.Hosts.Elements() {
 				val, _ := strconv.Unquote(value.String())
 				v = append(v, val)
 			}
+			for _, value := range data.ExternalHosts.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
 			optArgs.Host = &v
 		}
 		if !data.HostGroups.IsNull() {
@@ -209,6 +229,18 @@ func (r *SudoRuleHostMembershipResource) Create(ctx context.Context, req resourc
 		cmd_id = "msrh"
 	}
 
+	if !data.ExternalHosts.IsNull() {
+		var v []string
+		for _, value := range data.ExternalHosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		if err := checkSudoRuleExternalMembers(r.client, "host", v); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule host membership: %s", err))
+			return
+		}
+	}
+
 	_v, err := r.client.SudoruleAddHost(&args, &optArgs)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule host membership: %s", err))
@@ -322,6 +354,24 @@ func (r *SudoRuleHostMembershipResource) Read(ctx context.Context, req resource.
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
 			}
 		}
+		if !data.ExternalHosts.IsNull() {
+			var changedVals []string
+			for _, value := range data.ExternalHosts.Elements() {
+				val, err := strconv.Unquote(value.String())
+				if err != nil {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa sudo external host member failed with error %s", err))
+				}
+				if res.Result.Externalhost != nil && isStringListContainsCaseInsensistive(res.Result.Externalhost, &val) {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa sudo external host member %s is present in results", val))
+					changedVals = append(changedVals, val)
+				}
+			}
+			var diag diag.Diagnostics
+			data.ExternalHosts, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+			if diag.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+			}
+		}
 	}
 
 	// Save updated data into Terraform state
@@ -406,6 +456,45 @@ func (r *SudoRuleHostMembershipResource) Update(ctx context.Context, req resourc
 		}
 
 	}
+	// External hosts share the host option with the hosts managed by FreeIPA.
+	if !data.ExternalHosts.Equal(state.ExternalHosts) {
+		var statearr, planarr, addedExtHosts, deletedExtHosts []string
+
+		for _, value := range state.ExternalHosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.ExternalHosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedExtHosts = append(addedExtHosts, val)
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedExtHosts = append(deletedExtHosts, value)
+			}
+		}
+		if len(addedExtHosts) > 0 {
+			if err := checkSudoRuleExternalMembers(r.client, "host", addedExtHosts); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa sudo rule host membership: %s", err))
+				return
+			}
+			if memberAddOptArgs.Host != nil {
+				addedExtHosts = append(*memberAddOptArgs.Host, addedExtHosts...)
+			}
+			memberAddOptArgs.Host = &addedExtHosts
+			hasMemberAdd = true
+		}
+		if len(deletedExtHosts) > 0 {
+			if memberDelOptArgs.Host != nil {
+				deletedExtHosts = append(*memberDelOptArgs.Host, deletedExtHosts...)
+			}
+			memberDelOptArgs.Host = &deletedExtHosts
+			hasMemberDel = true
+		}
+	}
 	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
 	if hasMemberAdd {
 		_v, err := r.client.SudoruleAddHost(&memberAddArgs, &memberAddOptArgs)
@@ -465,12 +554,16 @@ func (r *SudoRuleHostMembershipResource) Delete(ctx context.Context, req resourc
 		v := []string{data.HostGroup.ValueString()}
 		optArgs.Hostgroup = &v
 	case "msrh":
-		if !data.Hosts.IsNull() {
+		if !data.Hosts.IsNull() || !data.ExternalHosts.IsNull() {
 			var v []string
 			for _, value := range data.Hosts.Elements() {
 				val, _ := strconv.Unquote(value.String())
 				v = append(v, val)
 			}
+			for _, value := range data.ExternalHosts.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
 			optArgs.Host = &v
 		}
 		if !data.HostGroups.IsNull() {
@@ -307,3 +307,64 @@ func TestAccFreeIPASudoRuleHostMembership_mutiple_CaseInsensitive(t *testing.T)
 		},
 	})
 }
+
+func TestAccFreeIPASudoRuleHostMembership_external(t *testing.T) {
+	testSudoRule := map[string]string{
+		"index":       "1",
+		"name":        "\"testacc-sudorule\"",
+		"description": "\"A sudo rule for acceptance tests\"",
+	}
+	testSudoHostMembership := map[string]string{
+		"index":          "1",
+		"name":           "freeipa_sudo_rule.sudorule-1.name",
+		"external_hosts": "[\"external-0.example.test\"]",
+		"identifier":     "\"hostmembers-1\"",
+	}
+	testSudoHostMembershipModified := map[string]string{
+		"index":          "1",
+		"name":           "freeipa_sudo_rule.sudorule-1.name",
+		"external_hosts": "[\"external-0.example.test\", \"external-1.example.test\"]",
+		"identifier":     "\"hostmembers-1\"",
+	}
+	testSudoDS := map[string]string{
+		"index": "1",
+		"name":  "freeipa_sudo_rule.sudorule-1.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleHostMembership_resource(testSudoHostMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_host_membership.sudo-host-membership-1", "name", "testacc-sudorule"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_host_membership.sudo-host-membership-1", "external_hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_host_membership.sudo-host-membership-1", "external_hosts.0", "external-0.example.test"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleHostMembership_resource(testSudoHostMembershipModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_sudo_rule_host_membership.sudo-host-membership-1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_host_membership.sudo-host-membership-1", "external_hosts.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_host_membership.sudo-host-membership-1", "external_hosts.1", "external-1.example.test"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "external_host.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "member_host.#", "0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleHostMembership_resource(testSudoHostMembershipModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -60,7 +60,7 @@ func (r *SudoRuleOptionResource) ConfigValidators(ctx context.Context) []resourc
 func (r *SudoRuleOptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "FreeIPA Sudo rule option resource",
+		MarkdownDescription: "FreeIPA Sudo rule option resource.\nTo manage all the options of a sudo rule and remove the unmanaged ones, use `freeipa_sudo_rule_options` instead.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -0,0 +1,272 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SudoRuleOptionsResource{}
+var _ resource.ResourceWithImportState = &SudoRuleOptionsResource{}
+
+func NewSudoRuleOptionsResource() resource.Resource {
+	return &SudoRuleOptionsResource{}
+}
+
+// SudoRuleOptionsResource defines the resource implementation.
+type SudoRuleOptionsResource struct {
+	client *ipa.Client
+}
+
+// SudoRuleOptionsResourceModel describes the resource data model.
+type SudoRuleOptionsResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Options types.Set    `tfsdk:"options"`
+}
+
+func (r *SudoRuleOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sudo_rule_options"
+}
+
+func (r *SudoRuleOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Sudo rule options resource.\nAuthoritative for the options of a sudo rule: the options that are not declared in this resource are removed from the sudo rule. Must not be used together with `freeipa_sudo_rule_option` on the same sudo rule.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Sudo rule name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"options": schema.SetAttribute{
+				MarkdownDescription: "Set of sudo options of the sudo rule. An empty set removes all the options of the sudo rule.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *SudoRuleOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SudoRuleOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SudoRuleOptionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.reconcileSudoRuleOptions(ctx, data.Name.ValueString(), data.Options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule options: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.Name.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SudoRuleOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SudoRuleOptionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.SudoruleShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.SudoruleShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.SudoruleShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Sudo rule not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa sudo rule: %s", err))
+			return
+		}
+	}
+
+	// All the options of the sudo rule are reported, so that unmanaged options show up as a diff.
+	options := []string{}
+	if res.Result.Ipasudoopt != nil {
+		options = *res.Result.Ipasudoopt
+	}
+	var diag diag.Diagnostics
+	data.Options, diag = types.SetValueFrom(ctx, types.StringType, options)
+	if diag.HasError() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+	}
+	data.Name = data.Id
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SudoRuleOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SudoRuleOptionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.reconcileSudoRuleOptions(ctx, data.Name.ValueString(), data.Options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa sudo rule options: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SudoRuleOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SudoRuleOptionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, value := range data.Options.Elements() {
+		val, _ := strconv.Unquote(value.String())
+		args := ipa.SudoruleRemoveOptionArgs{
+			Cn:         data.Name.ValueString(),
+			Ipasudoopt: []string{val},
+		}
+		_, err := r.client.SudoruleRemoveOption(&args, &ipa.SudoruleRemoveOptionOptionalArgs{})
+		if err != nil {
+			if strings.Contains(err.Error(), "NotFound") {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Sudo rule option %s already removed", val))
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa sudo rule option %s: %s", val, err))
+			return
+		}
+	}
+}
+
+func (r *SudoRuleOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// reconcileSudoRuleOptions adds the planned options missing from the sudo rule and removes the options that are not planned.
+// The options are compared with the ones returned by the server, so that options added outside of terraform are removed as well.
+func (r *SudoRuleOptionsResource) reconcileSudoRuleOptions(ctx context.Context, name string, options types.Set) error {
+	all := true
+	res, err := r.client.SudoruleShow(&ipa.SudoruleShowArgs{Cn: name}, &ipa.SudoruleShowOptionalArgs{All: &all})
+	if err != nil {
+		return err
+	}
+	var currentarr, planarr []string
+	if res.Result.Ipasudoopt != nil {
+		currentarr = *res.Result.Ipasudoopt
+	}
+	for _, value := range options.Elements() {
+		val, _ := strconv.Unquote(value.String())
+		planarr = append(planarr, val)
+	}
+
+	// The options are removed first so that the sudo rule never holds conflicting options like authenticate and !authenticate.
+	for _, value := range currentarr {
+		if slices.Contains(planarr, value) {
+			continue
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Removing freeipa sudo rule option %s", value))
+		args := ipa.SudoruleRemoveOptionArgs{
+			Cn:         name,
+			Ipasudoopt: []string{value},
+		}
+		_, err = r.client.SudoruleRemoveOption(&args, &ipa.SudoruleRemoveOptionOptionalArgs{})
+		if err != nil {
+			return err
+		}
+	}
+	for _, value := range planarr {
+		if slices.Contains(currentarr, value) {
+			continue
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Adding freeipa sudo rule option %s", value))
+		args := ipa.SudoruleAddOptionArgs{
+			Cn:         name,
+			Ipasudoopt: []string{value},
+		}
+		_, err = r.client.SudoruleAddOption(&args, &ipa.SudoruleAddOptionOptionalArgs{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
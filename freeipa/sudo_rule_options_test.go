@@ -0,0 +1,72 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPASudoRuleOptions_full(t *testing.T) {
+	testSudoRule := map[string]string{
+		"index":       "1",
+		"name":        "\"testacc-sudorule\"",
+		"description": "\"A sudo rule for acceptance tests\"",
+	}
+	testSudoRuleOptions := map[string]string{
+		"index":   "1",
+		"name":    "freeipa_sudo_rule.sudorule-1.name",
+		"options": "[\"!authenticate\", \"!requiretty\"]",
+	}
+	testSudoRuleOptionsModified := map[string]string{
+		"index":   "1",
+		"name":    "freeipa_sudo_rule.sudorule-1.name",
+		"options": "[\"!requiretty\", \"env_keep+=SSH_AUTH_SOCK\"]",
+	}
+	testSudoDS := map[string]string{
+		"index": "1",
+		"name":  "freeipa_sudo_rule_options.sudorule-options-1.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleOptions_resource(testSudoRuleOptions),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_options.sudorule-options-1", "id", "testacc-sudorule"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_options.sudorule-options-1", "options.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule_options.sudorule-options-1", "options.*", "!authenticate"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule_options.sudorule-options-1", "options.*", "!requiretty"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleOptions_resource(testSudoRuleOptionsModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_sudo_rule_options.sudorule-options-1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_options.sudorule-options-1", "options.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule_options.sudorule-options-1", "options.*", "env_keep+=SSH_AUTH_SOCK"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "option.#", "2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleOptions_resource(testSudoRuleOptionsModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	ipa "github.com/infra-monkey/go-freeipa/freeipa"
@@ -47,11 +49,12 @@ type SudoRuleRunAsGroupMembershipResource struct {
 
 // SudoRuleRunAsGroupMembershipResourceModel describes the resource data model.
 type SudoRuleRunAsGroupMembershipResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	RunAsGroup  types.String `tfsdk:"runasgroup"`
-	RunAsGroups types.List   `tfsdk:"runasgroups"`
-	Identifier  types.String `tfsdk:"identifier"`
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	RunAsGroup          types.String `tfsdk:"runasgroup"`
+	RunAsGroups         types.List   `tfsdk:"runasgroups"`
+	ExternalRunAsGroups types.List   `tfsdk:"external_runasgroups"`
+	Identifier          types.String `tfsdk:"identifier"`
 }
 
 func (r *SudoRuleRunAsGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,6 +67,10 @@ func (r *SudoRuleRunAsGroupMembershipResource) ConfigValidators(ctx context.Cont
 			path.MatchRoot("runasgroup"),
 			path.MatchRoot("runasgroups"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("runasgroup"),
+			path.MatchRoot("external_runasgroups"),
+		),
 	}
 }
 
@@ -96,12 +103,20 @@ func (r *SudoRuleRunAsGroupMembershipResource) Schema(ctx context.Context, req r
 				},
 			},
 			"runasgroups": schema.ListAttribute{
-				MarkdownDescription: "List of Run As Group to add to the sudo rule. Can be an external group (local group of ipa clients)",
+				MarkdownDescription: "List of Run As Group to add to the sudo rule. Use `external_runasgroups` for external groups (local groups of ipa clients)",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"external_runasgroups": schema.ListAttribute{
+				MarkdownDescription: "List of external Run As Groups (not managed by FreeIPA, like local groups of ipa clients) to add to the sudo rule. Groups that exist in FreeIPA must be added with `runasgroups`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
 			"identifier": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier to differentiate multiple sudo rule runasgroup membership resources on the same sudo rule. Manadatory for using runasgroups configurations.",
+				MarkdownDescription: "Unique identifier to differentiate multiple sudo rule runasgroup membership resources on the same sudo rule. Manadatory for using runasgroups/external_runasgroups configurations.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -153,16 +168,32 @@ func (r *SudoRuleRunAsGroupMembershipResource) Create(ctx context.Context, req r
 		optArgs.Group = &v
 		grp_id = "srraug"
 	}
-	if !data.RunAsGroups.IsNull() {
+	if !data.RunAsGroups.IsNull() || !data.ExternalRunAsGroups.IsNull() {
 		var v []string
 		for _, value := range data.RunAsGroups.Elements() {
 			val, _ := strconv.Unquote(value.String())
 			v = append(v, val)
 		}
+		for _, value := range data.ExternalRunAsGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
 		optArgs.Group = &v
 		grp_id = "msrraug"
 	}
 
+	if !data.ExternalRunAsGroups.IsNull() {
+		var v []string
+		for _, value := range data.ExternalRunAsGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		if err := checkSudoRuleExternalMembers(r.client, "group", v); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule runasgroup membership: %s", err))
+			return
+		}
+	}
+
 	_v, err := r.client.SudoruleAddRunasgroup(&args, &optArgs)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule runasgroup membership: %s", err))
@@ -249,6 +280,24 @@ func (r *SudoRuleRunAsGroupMembershipResource) Read(ctx context.Context, req res
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
 			}
 		}
+		if !data.ExternalRunAsGroups.IsNull() {
+			var changedVals []string
+			for _, value := range data.ExternalRunAsGroups.Elements() {
+				val, err := strconv.Unquote(value.String())
+				if err != nil {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa sudo external runasgroup member failed with error %s", err))
+				}
+				if res.Result.Ipasudorunasextgroup != nil && isStringListContainsCaseInsensistive(res.Result.Ipasudorunasextgroup, &val) {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa sudo external runasgroup member %s is present in results", val))
+					changedVals = append(changedVals, val)
+				}
+			}
+			var diag diag.Diagnostics
+			data.ExternalRunAsGroups, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+			if diag.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+			}
+		}
 	}
 
 	// Save updated data into Terraform state
@@ -308,6 +357,45 @@ func (r *SudoRuleRunAsGroupMembershipResource) Update(ctx context.Context, req r
 		}
 
 	}
+	// External run as groups share the group option with the groups managed by FreeIPA.
+	if !data.ExternalRunAsGroups.Equal(state.ExternalRunAsGroups) {
+		var statearr, planarr, addedExtRag, deletedExtRag []string
+
+		for _, value := range state.ExternalRunAsGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.ExternalRunAsGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedExtRag = append(addedExtRag, val)
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedExtRag = append(deletedExtRag, value)
+			}
+		}
+		if len(addedExtRag) > 0 {
+			if err := checkSudoRuleExternalMembers(r.client, "group", addedExtRag); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa sudo rule runasgroup membership: %s", err))
+				return
+			}
+			if memberAddOptArgs.Group != nil {
+				addedExtRag = append(*memberAddOptArgs.Group, addedExtRag...)
+			}
+			memberAddOptArgs.Group = &addedExtRag
+			hasMemberAdd = true
+		}
+		if len(deletedExtRag) > 0 {
+			if memberDelOptArgs.Group != nil {
+				deletedExtRag = append(*memberDelOptArgs.Group, deletedExtRag...)
+			}
+			memberDelOptArgs.Group = &deletedExtRag
+			hasMemberDel = true
+		}
+	}
 	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
 	if hasMemberAdd {
 		_v, err := r.client.SudoruleAddRunasgroup(&memberAddArgs, &memberAddOptArgs)
@@ -364,12 +452,16 @@ func (r *SudoRuleRunAsGroupMembershipResource) Delete(ctx context.Context, req r
 		v := []string{data.RunAsGroup.ValueString()}
 		optArgs.Group = &v
 	case "msrraug":
-		if !data.RunAsGroups.IsNull() {
+		if !data.RunAsGroups.IsNull() || !data.ExternalRunAsGroups.IsNull() {
 			var v []string
 			for _, value := range data.RunAsGroups.Elements() {
 				val, _ := strconv.Unquote(value.String())
 				v = append(v, val)
 			}
+			for _, value := range data.ExternalRunAsGroups.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
 			optArgs.Group = &v
 		}
 	}
@@ -235,3 +235,64 @@ func TestAccFreeIPASudoRuleRunAsGroupMembership_multiple_CaseInsensitive(t *test
 		},
 	})
 }
+
+func TestAccFreeIPASudoRuleRunAsGroupMembership_external(t *testing.T) {
+	testSudoRule := map[string]string{
+		"index":       "1",
+		"name":        "\"testacc-sudorule\"",
+		"description": "\"A sudo rule for acceptance tests\"",
+	}
+	testSudoRunAsGroupMembership := map[string]string{
+		"index":                "1",
+		"name":                 "freeipa_sudo_rule.sudorule-1.name",
+		"external_runasgroups": "[\"dba\"]",
+		"identifier":           "\"runasgroups-1\"",
+	}
+	testSudoRunAsGroupMembershipModified := map[string]string{
+		"index":                "1",
+		"name":                 "freeipa_sudo_rule.sudorule-1.name",
+		"external_runasgroups": "[\"dba\", \"wheel\"]",
+		"identifier":           "\"runasgroups-1\"",
+	}
+	testSudoDS := map[string]string{
+		"index": "1",
+		"name":  "freeipa_sudo_rule.sudorule-1.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleRunAsGroupMembership_resource(testSudoRunAsGroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasgroup_membership.sudorule-runasgroup-membership-1", "name", "testacc-sudorule"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasgroup_membership.sudorule-runasgroup-membership-1", "external_runasgroups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasgroup_membership.sudorule-runasgroup-membership-1", "external_runasgroups.0", "dba"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleRunAsGroupMembership_resource(testSudoRunAsGroupMembershipModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_sudo_rule_runasgroup_membership.sudorule-runasgroup-membership-1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasgroup_membership.sudorule-runasgroup-membership-1", "external_runasgroups.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasgroup_membership.sudorule-runasgroup-membership-1", "external_runasgroups.1", "wheel"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "external_runasgroup.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "runasgroup.#", "0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleRunAsGroupMembership_resource(testSudoRunAsGroupMembershipModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
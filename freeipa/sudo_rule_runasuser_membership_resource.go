@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	ipa "github.com/infra-monkey/go-freeipa/freeipa"
@@ -47,11 +49,12 @@ type SudoRuleRunAsUserMembershipResource struct {
 
 // SudoRuleRunAsUserMembershipResourceModel describes the resource data model.
 type SudoRuleRunAsUserMembershipResourceModel struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	RunAsUser  types.String `tfsdk:"runasuser"`
-	RunAsUsers types.List   `tfsdk:"runasusers"`
-	Identifier types.String `tfsdk:"identifier"`
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	RunAsUser          types.String `tfsdk:"runasuser"`
+	RunAsUsers         types.List   `tfsdk:"runasusers"`
+	ExternalRunAsUsers types.List   `tfsdk:"external_runasusers"`
+	Identifier         types.String `tfsdk:"identifier"`
 }
 
 func (r *SudoRuleRunAsUserMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,6 +67,10 @@ func (r *SudoRuleRunAsUserMembershipResource) ConfigValidators(ctx context.Conte
 			path.MatchRoot("runasuser"),
 			path.MatchRoot("runasusers"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("runasuser"),
+			path.MatchRoot("external_runasusers"),
+		),
 	}
 }
 
@@ -96,12 +103,20 @@ func (r *SudoRuleRunAsUserMembershipResource) Schema(ctx context.Context, req re
 				},
 			},
 			"runasusers": schema.ListAttribute{
-				MarkdownDescription: "List of Run As User to add to the sudo rule. Can be an external user (local user of ipa clients)",
+				MarkdownDescription: "List of Run As User to add to the sudo rule. Use `external_runasusers` for external users (local users of ipa clients)",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"external_runasusers": schema.ListAttribute{
+				MarkdownDescription: "List of external Run As Users (not managed by FreeIPA, like local users of ipa clients) to add to the sudo rule. Users that exist in FreeIPA must be added with `runasusers`.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
 			},
 			"identifier": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier to differentiate multiple sudo rule runasuser membership resources on the same sudo rule. Manadatory for using runasusers configurations.",
+				MarkdownDescription: "Unique identifier to differentiate multiple sudo rule runasuser membership resources on the same sudo rule. Manadatory for using runasusers/external_runasusers configurations.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -153,16 +168,32 @@ func (r *SudoRuleRunAsUserMembershipResource) Create(ctx context.Context, req re
 		optArgs.User = &v
 		usr_id = "srrau"
 	}
-	if !data.RunAsUsers.IsNull() {
+	if !data.RunAsUsers.IsNull() || !data.ExternalRunAsUsers.IsNull() {
 		var v []string
 		for _, value := range data.RunAsUsers.Elements() {
 			val, _ := strconv.Unquote(value.String())
 			v = append(v, val)
 		}
+		for _, value := range data.ExternalRunAsUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
 		optArgs.User = &v
 		usr_id = "msrrau"
 	}
 
+	if !data.ExternalRunAsUsers.IsNull() {
+		var v []string
+		for _, value := range data.ExternalRunAsUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		if err := checkSudoRuleExternalMembers(r.client, "user", v); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule runasuser membership: %s", err))
+			return
+		}
+	}
+
 	_v, err := r.client.SudoruleAddRunasuser(&args, &optArgs)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule runasuser membership: %s", err))
@@ -256,6 +287,24 @@ func (r *SudoRuleRunAsUserMembershipResource) Read(ctx context.Context, req reso
 				}
 			}
 		}
+		if !data.ExternalRunAsUsers.IsNull() {
+			var changedVals []string
+			for _, value := range data.ExternalRunAsUsers.Elements() {
+				val, err := strconv.Unquote(value.String())
+				if err != nil {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa sudo external runasuser member failed with error %s", err))
+				}
+				if res.Result.Ipasudorunasextuser != nil && isStringListContainsCaseInsensistive(res.Result.Ipasudorunasextuser, &val) {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa sudo external runasuser member %s is present in results", val))
+					changedVals = append(changedVals, val)
+				}
+			}
+			var diag diag.Diagnostics
+			data.ExternalRunAsUsers, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+			if diag.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+			}
+		}
 	}
 
 	// Save updated data into Terraform state
@@ -315,6 +364,45 @@ func (r *SudoRuleRunAsUserMembershipResource) Update(ctx context.Context, req re
 		}
 
 	}
+	// External run as users share the user option with the users managed by FreeIPA.
+	if !data.ExternalRunAsUsers.Equal(state.ExternalRunAsUsers) {
+		var statearr, planarr, addedExtRau, deletedExtRau []string
+
+		for _, value := range state.ExternalRunAsUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.ExternalRunAsUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedExtRau = append(addedExtRau, val)
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedExtRau = append(deletedExtRau, value)
+			}
+		}
+		if len(addedExtRau) > 0 {
+			if err := checkSudoRuleExternalMembers(r.client, "user", addedExtRau); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa sudo rule runasuser membership: %s", err))
+				return
+			}
+			if memberAddOptArgs.User != nil {
+				addedExtRau = append(*memberAddOptArgs.User, addedExtRau...)
+			}
+			memberAddOptArgs.User = &addedExtRau
+			hasMemberAdd = true
+		}
+		if len(deletedExtRau) > 0 {
+			if memberDelOptArgs.User != nil {
+				deletedExtRau = append(*memberDelOptArgs.User, deletedExtRau...)
+			}
+			memberDelOptArgs.User = &deletedExtRau
+			hasMemberDel = true
+		}
+	}
 	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
 	if hasMemberAdd {
 		_v, err := r.client.SudoruleAddRunasuser(&memberAddArgs, &memberAddOptArgs)
@@ -371,12 +459,16 @@ func (r *SudoRuleRunAsUserMembershipResource) Delete(ctx context.Context, req re
 		v := []string{data.RunAsUser.ValueString()}
 		optArgs.User = &v
 	case "msrrau":
-		if !data.RunAsUsers.IsNull() {
+		if !data.RunAsUsers.IsNull() || !data.ExternalRunAsUsers.IsNull() {
 			var v []string
 			for _, value := range data.RunAsUsers.Elements() {
 				val, _ := strconv.Unquote(value.String())
 				v = append(v, val)
 			}
+			for _, value := range data.ExternalRunAsUsers.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
 			optArgs.User = &v
 		}
 	}
@@ -239,3 +239,64 @@ func TestAccFreeIPASudoRuleRunAsUserMembership_multiple_CaseInsensitive(t *testi
 		},
 	})
 }
+
+func TestAccFreeIPASudoRuleRunAsUserMembership_external(t *testing.T) {
+	testSudoRule := map[string]string{
+		"index":       "1",
+		"name":        "\"testacc-sudorule\"",
+		"description": "\"A sudo rule for acceptance tests\"",
+	}
+	testSudoRunAsUserMembership := map[string]string{
+		"index":               "1",
+		"name":                "freeipa_sudo_rule.sudorule-1.name",
+		"external_runasusers": "[\"postgres\"]",
+		"identifier":          "\"runasusers-1\"",
+	}
+	testSudoRunAsUserMembershipModified := map[string]string{
+		"index":               "1",
+		"name":                "freeipa_sudo_rule.sudorule-1.name",
+		"external_runasusers": "[\"postgres\", \"oracle\"]",
+		"identifier":          "\"runasusers-1\"",
+	}
+	testSudoDS := map[string]string{
+		"index": "1",
+		"name":  "freeipa_sudo_rule.sudorule-1.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleRunAsUserMembership_resource(testSudoRunAsUserMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasuser_membership.sudorule-runasuser-membership-1", "name", "testacc-sudorule"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasuser_membership.sudorule-runasuser-membership-1", "external_runasusers.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasuser_membership.sudorule-runasuser-membership-1", "external_runasusers.0", "postgres"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleRunAsUserMembership_resource(testSudoRunAsUserMembershipModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_sudo_rule_runasuser_membership.sudorule-runasuser-membership-1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasuser_membership.sudorule-runasuser-membership-1", "external_runasusers.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_runasuser_membership.sudorule-runasuser-membership-1", "external_runasusers.1", "oracle"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "external_runasuser.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "runasuser.#", "0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleRunAsUserMembership_resource(testSudoRunAsUserMembershipModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	ipa "github.com/infra-monkey/go-freeipa/freeipa"
@@ -47,13 +49,14 @@ type SudoRuleUserMembershipResource struct {
 
 // SudoRuleUserMembershipResourceModel describes the resource data model.
 type SudoRuleUserMembershipResourceModel struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	User       types.String `tfsdk:"user"`
-	Users      types.List   `tfsdk:"users"`
-	Group      types.String `tfsdk:"group"`
-	Groups     types.List   `tfsdk:"groups"`
-	Identifier types.String `tfsdk:"identifier"`
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	User          types.String `tfsdk:"user"`
+	Users         types.List   `tfsdk:"users"`
+	Group         types.String `tfsdk:"group"`
+	Groups        types.List   `tfsdk:"groups"`
+	ExternalUsers types.List   `tfsdk:"external_users"`
+	Identifier    types.String `tfsdk:"identifier"`
 }
 
 func (r *SudoRuleUserMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,6 +85,14 @@ func (r *SudoRuleUserMembershipResource) ConfigValidators(ctx context.Context) [
 			path.MatchRoot("group"),
 			path.MatchRoot("groups"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("external_users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("external_users"),
+		),
 	}
 }
 
@@ -131,8 +142,16 @@ func (r *SudoRuleUserMembershipResource) Schema(ctx context.Context, req resourc
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"external_users": schema.ListAttribute{
+				MarkdownDescription: "List of external users (not managed by FreeIPA, like local accounts) to add to the sudo rule. Users that exist in FreeIPA must be added with `users`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
 			"identifier": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier to differentiate multiple sudo rule user membership resources on the same sudo rule. Manadatory for using users/groups configurations.",
+				MarkdownDescription: "Unique identifier to differentiate multiple sudo rule user membership resources on the same sudo rule. Manadatory for using users/groups/external_users configurations.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -189,13 +208,18 @@ func (r *SudoRuleUserMembershipResource) Create(ctx context.Context, req resourc
 		optArgs.Group = &v
 		cmd_id = "srug"
 	}
-	if !data.Users.IsNull() || !data.Groups.IsNull() {
-		if !data.Users.IsNull() {
+	if !data.Users.IsNull() || !data.Groups.IsNull() || !data.ExternalUsers.IsNull() {
+		// External users are added with the user option, FreeIPA stores the users it does not know as externaluser.
+		if !data.Users.IsNull() || !data.ExternalUsers.IsNull() {
 			var v []string
 			for _, value := range data.Users.Elements() {
 				val, _ := strconv.Unquote(value.String())
 				v = append(v, val)
 			}
+			for _, value := range data.ExternalUsers.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
 			optArgs.User = &v
 		}
 		if !data.Groups.IsNull() {
@@ -209,6 +233,18 @@ func (r *SudoRuleUserMembershipResource) Create(ctx context.Context, req resourc
 		cmd_id = "msru"
 	}
 
+	if !data.ExternalUsers.IsNull() {
+		var v []string
+		for _, value := range data.ExternalUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		if err := checkSudoRuleExternalMembers(r.client, "user", v); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule user membership: %s", err))
+			return
+		}
+	}
+
 	_v, err := r.client.SudoruleAddUser(&args, &optArgs)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa sudo rule user membership: %s", err))
@@ -284,7 +320,7 @@ func (r *SudoRuleUserMembershipResource) Read(ctx context.Context, req resource.
 			return
 		}
 	case "msru":
-		if res.Result.MemberuserUser == nil && res.Result.MemberuserGroup == nil {
+		if res.Result.MemberuserUser == nil && res.Result.MemberuserGroup == nil && res.Result.Externaluser == nil {
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
@@ -324,6 +360,24 @@ func (r *SudoRuleUserMembershipResource) Read(ctx context.Context, req resource.
 					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
 				}
 			}
+			if !data.ExternalUsers.IsNull() {
+				var changedVals []string
+				for _, value := range data.ExternalUsers.Elements() {
+					val, err := strconv.Unquote(value.String())
+					if err != nil {
+						tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa sudo external user member failed with error %s", err))
+					}
+					if res.Result.Externaluser != nil && isStringListContainsCaseInsensistive(res.Result.Externaluser, &val) {
+						tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa sudo external user member %s is present in results", val))
+						changedVals = append(changedVals, val)
+					}
+				}
+				var diag diag.Diagnostics
+				data.ExternalUsers, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+				if diag.HasError() {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+				}
+			}
 		}
 	}
 
@@ -409,6 +463,45 @@ func (r *SudoRuleUserMembershipResource) Update(ctx context.Context, req resourc
 		}
 
 	}
+	// External users share the user option with the users managed by FreeIPA.
+	if !data.ExternalUsers.Equal(state.ExternalUsers) {
+		var statearr, planarr, addedExtUsers, deletedExtUsers []string
+
+		for _, value := range state.ExternalUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.ExternalUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedExtUsers = append(addedExtUsers, val)
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedExtUsers = append(deletedExtUsers, value)
+			}
+		}
+		if len(addedExtUsers) > 0 {
+			if err := checkSudoRuleExternalMembers(r.client, "user", addedExtUsers); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa sudo rule user membership: %s", err))
+				return
+			}
+			if memberAddOptArgs.User != nil {
+				addedExtUsers = append(*memberAddOptArgs.User, addedExtUsers...)
+			}
+			memberAddOptArgs.User = &addedExtUsers
+			hasMemberAdd = true
+		}
+		if len(deletedExtUsers) > 0 {
+			if memberDelOptArgs.User != nil {
+				deletedExtUsers = append(*memberDelOptArgs.User, deletedExtUsers...)
+			}
+			memberDelOptArgs.User = &deletedExtUsers
+			hasMemberDel = true
+		}
+	}
 	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
 	if hasMemberAdd {
 		_v, err := r.client.SudoruleAddUser(&memberAddArgs, &memberAddOptArgs)
@@ -468,12 +561,16 @@ func (r *SudoRuleUserMembershipResource) Delete(ctx context.Context, req resourc
 		v := []string{data.Group.ValueString()}
 		optArgs.Group = &v
 	case "msru":
-		if !data.Users.IsNull() {
+		if !data.Users.IsNull() || !data.ExternalUsers.IsNull() {
 			var v []string
 			for _, value := range data.Users.Elements() {
 				val, _ := strconv.Unquote(value.String())
 				v = append(v, val)
 			}
+			for _, value := range data.ExternalUsers.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
 			optArgs.User = &v
 		}
 		if !data.Groups.IsNull() {
@@ -546,3 +643,26 @@ func parseSudoRuleUserMembershipID(id string) (string, string, string, error) {
 
 	return name, _type, user, nil
 }
+
+// checkSudoRuleExternalMembers returns an error when one of the external members exists in FreeIPA.
+// FreeIPA stores the members it knows as regular members, they would never be read back as external members.
+func checkSudoRuleExternalMembers(client *ipa.Client, memberType string, names []string) error {
+	for _, name := range names {
+		var err error
+		switch memberType {
+		case "user":
+			_, err = client.UserShow(&ipa.UserShowArgs{}, &ipa.UserShowOptionalArgs{UID: &name})
+		case "group":
+			_, err = client.GroupShow(&ipa.GroupShowArgs{Cn: name}, &ipa.GroupShowOptionalArgs{})
+		case "host":
+			_, err = client.HostShow(&ipa.HostShowArgs{Fqdn: name}, &ipa.HostShowOptionalArgs{})
+		}
+		if err == nil {
+			return fmt.Errorf("the %s %s exists in FreeIPA and cannot be added as an external member", memberType, name)
+		}
+		if !strings.Contains(err.Error(), "NotFound") {
+			return fmt.Errorf("error looking up the external %s %s: %s", memberType, name, err)
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@
 package freeipa
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -226,3 +227,83 @@ func TestAccFreeIPASudoRuleUserMembership_mutiple(t *testing.T) {
 		},
 	})
 }
+
+func TestAccFreeIPASudoRuleUserMembership_external(t *testing.T) {
+	testSudoRule := map[string]string{
+		"index":       "1",
+		"name":        "\"testacc-sudorule\"",
+		"description": "\"A sudo rule for acceptance tests\"",
+	}
+	testSudoUserMembership := map[string]string{
+		"index":          "1",
+		"name":           "freeipa_sudo_rule.sudorule-1.name",
+		"external_users": "[\"postgres\"]",
+		"identifier":     "\"usermembers-1\"",
+	}
+	testSudoUserMembershipModified := map[string]string{
+		"index":          "1",
+		"name":           "freeipa_sudo_rule.sudorule-1.name",
+		"external_users": "[\"postgres\", \"oracle\"]",
+		"identifier":     "\"usermembers-1\"",
+	}
+	testSudoUserMembershipNoIdentifier := map[string]string{
+		"index":          "1",
+		"name":           "freeipa_sudo_rule.sudorule-1.name",
+		"external_users": "[\"postgres\"]",
+	}
+	testSudoUserMembershipIpaUser := map[string]string{
+		"index":          "2",
+		"name":           "freeipa_sudo_rule.sudorule-1.name",
+		"external_users": "[\"admin\"]",
+		"identifier":     "\"usermembers-2\"",
+	}
+	testSudoDS := map[string]string{
+		"index": "1",
+		"name":  "freeipa_sudo_rule.sudorule-1.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleUserMembership_resource(testSudoUserMembershipNoIdentifier),
+				ExpectError: regexp.MustCompile("identifier"),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleUserMembership_resource(testSudoUserMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_user_membership.sudo-user-membership-1", "name", "testacc-sudorule"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_user_membership.sudo-user-membership-1", "external_users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_user_membership.sudo-user-membership-1", "external_users.0", "postgres"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleUserMembership_resource(testSudoUserMembershipModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_sudo_rule_user_membership.sudo-user-membership-1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_user_membership.sudo-user-membership-1", "external_users.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule_user_membership.sudo-user-membership-1", "external_users.1", "oracle"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "external_user.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_sudo_rule.sudorule-1", "member_user.#", "0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleUserMembership_resource(testSudoUserMembershipModified) + testAccFreeIPASudoRule_datasource(testSudoDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPASudoRule_resource(testSudoRule) + testAccFreeIPASudoRuleUserMembership_resource(testSudoUserMembershipModified) + testAccFreeIPASudoRuleUserMembership_resource(testSudoUserMembershipIpaUser),
+				ExpectError: regexp.MustCompile("exists in FreeIPA"),
+			},
+		},
+	})
+}
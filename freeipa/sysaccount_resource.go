@@ -0,0 +1,350 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SysaccountResource{}
+var _ resource.ResourceWithImportState = &SysaccountResource{}
+
+func NewSysaccountResource() resource.Resource {
+	return &SysaccountResource{}
+}
+
+// SysaccountResource defines the resource implementation.
+type SysaccountResource struct {
+	client *ipa.Client
+}
+
+// SysaccountResourceModel describes the resource data model.
+type SysaccountResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+	Privileged        types.Bool   `tfsdk:"privileged"`
+	Disabled          types.Bool   `tfsdk:"disabled"`
+	BindDn            types.String `tfsdk:"bind_dn"`
+}
+
+func (r *SysaccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sysaccount"
+}
+
+func (r *SysaccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA system account resource.\n" +
+			"System accounts are LDAP bind users stored in `cn=sysaccounts,cn=etc`, used by applications integrating with the FreeIPA LDAP directory. " +
+			"FreeIPA only assigns privileges to roles: the privileges of a system account are granted by adding it to a role " +
+			"with the `sysaccounts` attribute of `freeipa_role_membership`, the privileges being added to the role with `freeipa_role_privilege_membership`.\n\n" +
+			"The system account commands are only available on FreeIPA 4.13 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "System account name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "System account description",
+				Optional:            true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password of the system account. This attribute is write-only and never stored in the state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the password. Change it to update the system account with a new `password_wo`.",
+				Optional:            true,
+			},
+			"privileged": schema.BoolAttribute{
+				MarkdownDescription: "Allow the system account to change the passwords of users without requiring them to reset their password at the next login. This flag does not grant any privilege.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Disable the system account",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"bind_dn": schema.StringAttribute{
+				MarkdownDescription: "DN used by the applications to bind with the system account",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SysaccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SysaccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config SysaccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.SysaccountAddOptionalArgs{
+		Userpassword: config.PasswordWo.ValueStringPointer(),
+		Privileged:   data.Privileged.ValueBoolPointer(),
+	}
+
+	args := ipa.SysaccountAddArgs{
+		UID: data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if data.Disabled.ValueBool() {
+		optArgs.Nsaccountlock = data.Disabled.ValueBoolPointer()
+	}
+
+	res, err := r.client.SysaccountAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.Append(sysaccountClientError("Error creating freeipa sysaccount", err)...)
+		return
+	}
+
+	data.Id = data.Name
+	data.BindDn = types.StringValue(res.Result.Dn)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SysaccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SysaccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.SysaccountShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.SysaccountShowArgs{
+		UID: data.Id.ValueString(),
+	}
+
+	res, err := r.client.SysaccountShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Sysaccount not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.Append(sysaccountClientError("Error reading freeipa sysaccount", err)...)
+			return
+		}
+	}
+
+	data.Name = types.StringValue(res.Result.UID)
+	data.BindDn = types.StringValue(res.Result.Dn)
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Privileged != nil {
+		data.Privileged = types.BoolValue(*res.Result.Privileged)
+	} else {
+		data.Privileged = types.BoolValue(false)
+	}
+	if res.Result.Nsaccountlock != nil {
+		data.Disabled = types.BoolValue(*res.Result.Nsaccountlock)
+	} else {
+		data.Disabled = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SysaccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state, config SysaccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.SysaccountModArgs{
+		UID: data.Id.ValueString(),
+	}
+	optArgs := ipa.SysaccountModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	// The password is never read back, it is only sent when its version changes.
+	if !data.PasswordWoVersion.Equal(state.PasswordWoVersion) {
+		optArgs.Userpassword = config.PasswordWo.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Privileged.Equal(state.Privileged) {
+		optArgs.Privileged = data.Privileged.ValueBoolPointer()
+		hasChange = true
+	}
+	if !data.Disabled.Equal(state.Disabled) {
+		if data.Disabled.ValueBool() {
+			_, err := r.client.SysaccountDisable(&ipa.SysaccountDisableArgs{UID: data.Id.ValueString()}, &ipa.SysaccountDisableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disabling freeipa sysaccount: %s", err))
+				return
+			}
+		} else {
+			_, err := r.client.SysaccountEnable(&ipa.SysaccountEnableArgs{UID: data.Id.ValueString()}, &ipa.SysaccountEnableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error enabling freeipa sysaccount: %s", err))
+				return
+			}
+		}
+	}
+
+	if hasChange {
+		_, err := r.client.SysaccountMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa sysaccount: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SysaccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SysaccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.SysaccountDelArgs{
+		UID: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.SysaccountDel(&args, &ipa.SysaccountDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa sysaccount: %s", err))
+		return
+	}
+}
+
+func (r *SysaccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	all := true
+	res, err := r.client.SysaccountShow(&ipa.SysaccountShowArgs{UID: req.ID}, &ipa.SysaccountShowOptionalArgs{All: &all})
+	if err != nil {
+		resp.Diagnostics.Append(sysaccountClientError("Error reading freeipa sysaccount", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), *res.Result.Description)...)
+	}
+}
+
+// sysaccountClientError reports a client error, explaining the server version requirement
+// when the server does not know the sysaccount commands.
+func sysaccountClientError(summary string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if strings.Contains(err.Error(), "unknown command") {
+		diags.AddError("Unsupported FreeIPA Server", fmt.Sprintf("%s: the sysaccount commands are only available on FreeIPA 4.13 or later, the server does not support them (%s)", summary, err))
+		return diags
+	}
+	diags.AddError("Client Error", fmt.Sprintf("%s: %s", summary, err))
+	return diags
+}
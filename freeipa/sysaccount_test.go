@@ -0,0 +1,120 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPASysaccount_full(t *testing.T) {
+	testSysaccount := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-sysaccount\"",
+		"password_wo": "\"Secret123\"",
+	}
+	testSysaccountModified := map[string]string{
+		"index":               "0",
+		"name":                "\"testacc-sysaccount\"",
+		"password_wo":         "\"Secret456\"",
+		"password_wo_version": "2",
+		"description":         "\"System account for acceptance tests\"",
+		"privileged":          "true",
+		"disabled":            "true",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASysaccount_resource(testSysaccount),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sysaccount.sysaccount-0", "name", "testacc-sysaccount"),
+					resource.TestCheckResourceAttr("freeipa_sysaccount.sysaccount-0", "privileged", "false"),
+					resource.TestCheckResourceAttr("freeipa_sysaccount.sysaccount-0", "disabled", "false"),
+					resource.TestMatchResourceAttr("freeipa_sysaccount.sysaccount-0", "bind_dn", regexp.MustCompile("^uid=testacc-sysaccount,cn=sysaccounts,cn=etc,")),
+					resource.TestCheckNoResourceAttr("freeipa_sysaccount.sysaccount-0", "password_wo"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASysaccount_resource(testSysaccountModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_sysaccount.sysaccount-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sysaccount.sysaccount-0", "description", "System account for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_sysaccount.sysaccount-0", "privileged", "true"),
+					resource.TestCheckResourceAttr("freeipa_sysaccount.sysaccount-0", "disabled", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASysaccount_resource(testSysaccountModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPASysaccount_role(t *testing.T) {
+	testSysaccount := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-sysaccount\"",
+		"password_wo": "\"Secret123\"",
+	}
+	testRole := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-role\"",
+	}
+	testRoleMembership := map[string]string{
+		"index":       "0",
+		"name":        "freeipa_role.role-0.name",
+		"sysaccounts": "[freeipa_sysaccount.sysaccount-0.name]",
+		"identifier":  "\"sysaccounts-0\"",
+	}
+	testRoleDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_role.role-0.name",
+	}
+	base := testAccFreeIPAProvider() + testAccFreeIPASysaccount_resource(testSysaccount) + testAccFreeIPARole_resource(testRole) + testAccFreeIPARoleMembership_resource(testRoleMembership)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "sysaccounts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "sysaccounts.0", "testacc-sysaccount"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPARole_datasource(testRoleDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_role.role-0", "member_sysaccount.0", "testacc-sysaccount"),
+				),
+			},
+			{
+				Config: base + testAccFreeIPARole_datasource(testRoleDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
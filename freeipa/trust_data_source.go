@@ -0,0 +1,149 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TrustDataSource{}
+var _ datasource.DataSourceWithConfigure = &TrustDataSource{}
+
+func NewTrustDataSource() datasource.DataSource {
+	return &TrustDataSource{}
+}
+
+// TrustDataSource defines the data source implementation.
+type TrustDataSource struct {
+	client *ipa.Client
+}
+
+// TrustDataSourceModel describes the data source data model.
+type TrustDataSourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Realm              types.String `tfsdk:"realm"`
+	FlatName           types.String `tfsdk:"flat_name"`
+	Sid                types.String `tfsdk:"sid"`
+	TrustType          types.String `tfsdk:"trust_type"`
+	Direction          types.String `tfsdk:"direction"`
+	TrustStatus        types.String `tfsdk:"trust_status"`
+	AdditionalSuffixes types.List   `tfsdk:"additional_suffixes"`
+}
+
+func (r *TrustDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trust"
+}
+
+func (r *TrustDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA trust data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"realm": schema.StringAttribute{
+				MarkdownDescription: "Realm name of the trusted domain",
+				Required:            true,
+			},
+			"flat_name": schema.StringAttribute{
+				MarkdownDescription: "NetBIOS name of the trusted domain",
+				Computed:            true,
+			},
+			"sid": schema.StringAttribute{
+				MarkdownDescription: "Security identifier of the trusted domain",
+				Computed:            true,
+			},
+			"trust_type": schema.StringAttribute{
+				MarkdownDescription: "Trust type as reported by the server",
+				Computed:            true,
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "Trust direction as reported by the server",
+				Computed:            true,
+			},
+			"trust_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the trust as reported by the server",
+				Computed:            true,
+			},
+			"additional_suffixes": schema.ListAttribute{
+				MarkdownDescription: "Additional UPN suffixes of the trusted domain",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *TrustDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrustDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TrustDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	res, err := r.client.TrustShow(&ipa.TrustShowArgs{Cn: data.Realm.ValueString()}, &ipa.TrustShowOptionalArgs{All: &all})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa trust %s: %s", data.Realm.ValueString(), err))
+		return
+	}
+
+	data.FlatName = types.StringPointerValue(res.Result.Ipantflatname)
+	data.Sid = types.StringPointerValue(res.Result.Ipanttrusteddomainsid)
+	data.TrustType = types.StringPointerValue(res.Result.Trusttype)
+	data.Direction = types.StringPointerValue(res.Result.Trustdirection)
+	data.TrustStatus = types.StringPointerValue(res.Result.Truststatus)
+	suffixes := []string{}
+	if res.Result.Ipantadditionalsuffixes != nil {
+		suffixes = *res.Result.Ipantadditionalsuffixes
+	}
+	var diag diag.Diagnostics
+	data.AdditionalSuffixes, diag = types.ListValueFrom(ctx, types.StringType, suffixes)
+	if diag.HasError() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+	}
+	data.Id = types.StringValue(res.Result.Cn)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,141 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TrustDomainsDataSource{}
+var _ datasource.DataSourceWithConfigure = &TrustDomainsDataSource{}
+
+func NewTrustDomainsDataSource() datasource.DataSource {
+	return &TrustDomainsDataSource{}
+}
+
+// TrustDomainsDataSource defines the data source implementation.
+type TrustDomainsDataSource struct {
+	client *ipa.Client
+}
+
+// TrustDomainsDataSourceModel describes the data source data model.
+type TrustDomainsDataSourceModel struct {
+	Id      types.String                 `tfsdk:"id"`
+	Realm   types.String                 `tfsdk:"realm"`
+	Domains []TrustDomainDataSourceModel `tfsdk:"domains"`
+}
+
+// TrustDomainDataSourceModel describes a domain of the trusted forest.
+type TrustDomainDataSourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	FlatName types.String `tfsdk:"flat_name"`
+	Sid      types.String `tfsdk:"sid"`
+}
+
+func (r *TrustDomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trust_domains"
+}
+
+func (r *TrustDomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA trusted domains data source.\nLists the domains of a trusted forest.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"realm": schema.StringAttribute{
+				MarkdownDescription: "Realm name of the trust",
+				Required:            true,
+			},
+			"domains": schema.ListNestedAttribute{
+				MarkdownDescription: "Domains of the trusted forest",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the domain",
+							Computed:            true,
+						},
+						"flat_name": schema.StringAttribute{
+							MarkdownDescription: "NetBIOS name of the domain",
+							Computed:            true,
+						},
+						"sid": schema.StringAttribute{
+							MarkdownDescription: "Security identifier of the domain",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TrustDomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrustDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TrustDomainsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	res, err := r.client.TrustdomainFind("", &ipa.TrustdomainFindArgs{Trustcn: data.Realm.ValueString()}, &ipa.TrustdomainFindOptionalArgs{All: &all})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa domains of trust %s: %s", data.Realm.ValueString(), err))
+		return
+	}
+
+	data.Domains = []TrustDomainDataSourceModel{}
+	for _, domain := range res.Result {
+		data.Domains = append(data.Domains, TrustDomainDataSourceModel{
+			Name:     types.StringValue(domain.Cn),
+			FlatName: types.StringPointerValue(domain.Ipantflatname),
+			Sid:      types.StringPointerValue(domain.Ipanttrusteddomainsid),
+		})
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read %d domains of freeipa trust %s", len(data.Domains), data.Realm.ValueString()))
+	data.Id = data.Realm
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,383 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TrustResource{}
+var _ resource.ResourceWithImportState = &TrustResource{}
+
+func NewTrustResource() resource.Resource {
+	return &TrustResource{}
+}
+
+// TrustResource defines the resource implementation.
+type TrustResource struct {
+	client *ipa.Client
+}
+
+// TrustResourceModel describes the resource data model.
+type TrustResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Realm                 types.String `tfsdk:"realm"`
+	TrustType             types.String `tfsdk:"trust_type"`
+	Direction             types.String `tfsdk:"direction"`
+	External              types.Bool   `tfsdk:"external"`
+	RealmServer           types.String `tfsdk:"realm_server"`
+	Admin                 types.String `tfsdk:"admin"`
+	AdminPasswordWo       types.String `tfsdk:"admin_password_wo"`
+	SharedSecretWo        types.String `tfsdk:"shared_secret_wo"`
+	SharedSecretWoVersion types.Int64  `tfsdk:"shared_secret_wo_version"`
+	RangeType             types.String `tfsdk:"range_type"`
+	BaseId                types.Int64  `tfsdk:"base_id"`
+	RangeSize             types.Int64  `tfsdk:"range_size"`
+	FlatName              types.String `tfsdk:"flat_name"`
+	Sid                   types.String `tfsdk:"sid"`
+	TrustStatus           types.String `tfsdk:"trust_status"`
+}
+
+func (r *TrustResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trust"
+}
+
+func (r *TrustResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("admin"),
+			path.MatchRoot("shared_secret_wo"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("admin"),
+			path.MatchRoot("admin_password_wo"),
+		),
+	}
+}
+
+func (r *TrustResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA trust resource.\n\n" +
+			"The trust is established either with the credentials of an administrator of the trusted domain (`admin` and `admin_password_wo`) " +
+			"or with a shared secret (`shared_secret_wo`) that must also be configured on the trusted domain side.\n\n" +
+			"A trust cannot be modified: changing the realm, the trust type, the direction, `external` or `shared_secret_wo_version` replaces the trust.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"realm": schema.StringAttribute{
+				MarkdownDescription: "Realm name of the trusted domain",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trust_type": schema.StringAttribute{
+				MarkdownDescription: "Trust type. Only `ad` is supported. Defaults to `ad`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ad"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ad"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "Direction of the trust (`one-way` or `two-way`). A one-way trust lets the trusted domain users access IPA resources. Defaults to `one-way`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("one-way"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("one-way", "two-way"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external": schema.BoolAttribute{
+				MarkdownDescription: "Establish an external trust to a domain in another forest. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"realm_server": schema.StringAttribute{
+				MarkdownDescription: "Domain controller of the trusted domain. Only used when the trust is established.",
+				Optional:            true,
+			},
+			"admin": schema.StringAttribute{
+				MarkdownDescription: "Administrative account of the trusted domain. Only used when the trust is established.",
+				Optional:            true,
+			},
+			"admin_password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password of the administrative account. This attribute is write-only and never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"shared_secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Shared secret of the trust. This attribute is write-only and never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"shared_secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the shared secret. Change it to re-establish the trust with a new `shared_secret_wo`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"range_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the ID range created for the trusted domain (`ipa-ad-trust` or `ipa-ad-trust-posix`). Detected by the server when not set. Only used when the trust is established.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ipa-ad-trust", "ipa-ad-trust-posix"),
+				},
+			},
+			"base_id": schema.Int64Attribute{
+				MarkdownDescription: "First Posix ID of the range reserved for the trusted domain. Only used when the trust is established.",
+				Optional:            true,
+			},
+			"range_size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the ID range reserved for the trusted domain. Only used when the trust is established.",
+				Optional:            true,
+			},
+			"flat_name": schema.StringAttribute{
+				MarkdownDescription: "NetBIOS name of the trusted domain",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sid": schema.StringAttribute{
+				MarkdownDescription: "Security identifier of the trusted domain",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"trust_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the trust as reported by the server",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TrustResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrustResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config TrustResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.TrustAddOptionalArgs{
+		TrustType: data.TrustType.ValueStringPointer(),
+	}
+
+	args := ipa.TrustAddArgs{
+		Cn: data.Realm.ValueString(),
+	}
+	if data.Direction.ValueString() == "two-way" {
+		v := true
+		optArgs.Bidirectional = &v
+	}
+	if data.External.ValueBool() {
+		optArgs.External = data.External.ValueBoolPointer()
+	}
+	if !data.RealmServer.IsNull() {
+		optArgs.RealmServer = data.RealmServer.ValueStringPointer()
+	}
+	if !data.Admin.IsNull() {
+		optArgs.RealmAdmin = data.Admin.ValueStringPointer()
+		optArgs.RealmPasswd = config.AdminPasswordWo.ValueStringPointer()
+	}
+	if !config.SharedSecretWo.IsNull() {
+		optArgs.TrustSecret = config.SharedSecretWo.ValueStringPointer()
+	}
+	if !data.RangeType.IsNull() {
+		optArgs.RangeType = data.RangeType.ValueStringPointer()
+	}
+	if !data.BaseId.IsNull() {
+		v := int(data.BaseId.ValueInt64())
+		optArgs.BaseID = &v
+	}
+	if !data.RangeSize.IsNull() {
+		v := int(data.RangeSize.ValueInt64())
+		optArgs.RangeSize = &v
+	}
+
+	res, err := r.client.TrustAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa trust: %s", err))
+		return
+	}
+
+	data.FlatName = types.StringPointerValue(res.Result.Ipantflatname)
+	data.Sid = types.StringPointerValue(res.Result.Ipanttrusteddomainsid)
+	data.TrustStatus = types.StringPointerValue(res.Result.Truststatus)
+	data.Id = data.Realm
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TrustResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.TrustShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.TrustShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.TrustShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Trust not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa trust: %s", err))
+			return
+		}
+	}
+
+	// The server may return the realm with a different case, keep the configured value to avoid a replacement
+	if !strings.EqualFold(data.Realm.ValueString(), res.Result.Cn) {
+		data.Realm = types.StringValue(res.Result.Cn)
+	}
+	data.FlatName = types.StringPointerValue(res.Result.Ipantflatname)
+	data.Sid = types.StringPointerValue(res.Result.Ipanttrusteddomainsid)
+	data.TrustStatus = types.StringPointerValue(res.Result.Truststatus)
+	if res.Result.Trustdirection != nil {
+		if strings.Contains(strings.ToLower(*res.Result.Trustdirection), "two-way") {
+			data.Direction = types.StringValue("two-way")
+		} else {
+			data.Direction = types.StringValue("one-way")
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TrustResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TrustResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The trust cannot be modified: the attributes that do not require a replacement
+	// are only used when the trust is established.
+	data.Id = state.Id
+	data.FlatName = state.FlatName
+	data.Sid = state.Sid
+	data.TrustStatus = state.TrustStatus
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TrustResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.TrustDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.TrustDel(&args, &ipa.TrustDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa trust: %s", err))
+		return
+	}
+}
+
+func (r *TrustResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("realm"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("trust_type"), "ad")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("external"), false)...)
+}
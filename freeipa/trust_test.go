@@ -0,0 +1,54 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Establishing a trust requires an Active Directory domain controller that is not
+// available in the acceptance test environment, only the configuration checks are tested.
+func TestAccFreeIPATrust_credentials(t *testing.T) {
+	testTrustBoth := map[string]string{
+		"index":             "0",
+		"realm":             "\"ad.testacc.lan\"",
+		"admin":             "\"Administrator\"",
+		"admin_password_wo": "\"P@ssword\"",
+		"shared_secret_wo":  "\"S3cret\"",
+	}
+	testTrustNoPassword := map[string]string{
+		"index": "0",
+		"realm": "\"ad.testacc.lan\"",
+		"admin": "\"Administrator\"",
+	}
+	testTrustNone := map[string]string{
+		"index":     "0",
+		"realm":     "\"ad.testacc.lan\"",
+		"direction": "\"two-way\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPATrust_resource(testTrustBoth),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPATrust_resource(testTrustNoPassword),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPATrust_resource(testTrustNone),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
@@ -0,0 +1,275 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserCertmapdataResource{}
+var _ resource.ResourceWithImportState = &UserCertmapdataResource{}
+
+func NewUserCertmapdataResource() resource.Resource {
+	return &UserCertmapdataResource{}
+}
+
+// UserCertmapdataResource defines the resource implementation.
+type UserCertmapdataResource struct {
+	client *ipa.Client
+}
+
+// UserCertmapdataResourceModel describes the resource data model.
+type UserCertmapdataResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	User        types.String `tfsdk:"user"`
+	Issuer      types.String `tfsdk:"issuer"`
+	Subject     types.String `tfsdk:"subject"`
+	Certmapdata types.String `tfsdk:"certmapdata"`
+}
+
+func (r *UserCertmapdataResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_certmapdata"
+}
+
+func (r *UserCertmapdataResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA user certificate mapping data resource.\nAdds an issuer/subject pair to the certificate mapping data of an existing user, independently of the `freeipa_user` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Login of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "Issuer of the certificate (ie: `CN=Smart Card CA,O=EXAMPLE.LAN`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the certificate (ie: `CN=jdoe,O=EXAMPLE.LAN`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certmapdata": schema.StringAttribute{
+				MarkdownDescription: "Certificate mapping data as stored on the user entry (`X509:<I>issuer<S>subject`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserCertmapdataResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserCertmapdataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserCertmapdataResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.UserAddCertmapdataOptionalArgs{
+		UID:     data.User.ValueStringPointer(),
+		Issuer:  data.Issuer.ValueStringPointer(),
+		Subject: data.Subject.ValueStringPointer(),
+	}
+	_, err := r.client.UserAddCertmapdata(&ipa.UserAddCertmapdataArgs{}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa user certmapdata: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", encodeSlash(data.User.ValueString()), encodeSlash(data.Issuer.ValueString()), encodeSlash(data.Subject.ValueString())))
+	data.Certmapdata = types.StringValue(certmapdataValue(data.Issuer.ValueString(), data.Subject.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserCertmapdataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserCertmapdataResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.UserShowOptionalArgs{
+		All: &all,
+		UID: data.User.ValueStringPointer(),
+	}
+	res, err := r.client.UserShow(&ipa.UserShowArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] User not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa user: %s", err))
+			return
+		}
+	}
+
+	expected := certmapdataValue(data.Issuer.ValueString(), data.Subject.ValueString())
+	found := false
+	if res.Result.Ipacertmapdata != nil {
+		for _, v := range *res.Result.Ipacertmapdata {
+			if strings.EqualFold(v, expected) {
+				found = true
+				data.Certmapdata = types.StringValue(v)
+				break
+			}
+		}
+	}
+	if !found {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Certmapdata %s not found on user %s", expected, data.User.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *UserCertmapdataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserCertmapdataResourceModel
+
+	// All attributes require a replacement, there is nothing to update.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserCertmapdataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserCertmapdataResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.UserRemoveCertmapdataOptionalArgs{
+		UID:     data.User.ValueStringPointer(),
+		Issuer:  data.Issuer.ValueStringPointer(),
+		Subject: data.Subject.ValueStringPointer(),
+	}
+	_, err := r.client.UserRemoveCertmapdata(&ipa.UserRemoveCertmapdataArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] User not found, nothing to remove")
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa user certmapdata: %s", err))
+		return
+	}
+}
+
+func (r *UserCertmapdataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("The ID %q must use the format <user>/<issuer>/<subject>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), decodeSlash(idParts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issuer"), decodeSlash(idParts[1]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject"), decodeSlash(idParts[2]))...)
+}
+
+// certmapdataValue returns the certificate mapping data stored by FreeIPA for an issuer/subject pair.
+// FreeIPA stores the distinguished names in X.500 order, which is the reverse of the LDAP order used in the api.
+func certmapdataValue(issuer string, subject string) string {
+	return fmt.Sprintf("X509:<I>%s<S>%s", reverseDN(issuer), reverseDN(subject))
+}
+
+// reverseDN reverses the order of the RDNs of a distinguished name, keeping escaped commas.
+func reverseDN(dn string) string {
+	var rdns []string
+	var current strings.Builder
+	escaped := false
+	for _, c := range dn {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == ',':
+			rdns = append(rdns, strings.TrimSpace(current.String()))
+			current.Reset()
+			continue
+		}
+		current.WriteRune(c)
+	}
+	rdns = append(rdns, strings.TrimSpace(current.String()))
+	for i, j := 0, len(rdns)-1; i < j; i, j = i+1, j-1 {
+		rdns[i], rdns[j] = rdns[j], rdns[i]
+	}
+	return strings.Join(rdns, ",")
+}
@@ -19,8 +19,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -85,6 +87,24 @@ type UserDataSourceModel struct {
 	MemberOfIndirectGroup    types.List   `tfsdk:"memberof_indirect_group"`
 	MemberOfIndirectSudoRule types.List   `tfsdk:"memberof_indirect_sudorule"`
 	MemberOfIndirectHBACRule types.List   `tfsdk:"memberof_indirect_hbacrule"`
+	Subids                   types.List   `tfsdk:"subids"`
+}
+
+// UserSubidDataSourceModel describes a subordinate ID range of the user.
+type UserSubidDataSourceModel struct {
+	UniqueId     types.String `tfsdk:"unique_id"`
+	SubuidBase   types.Int64  `tfsdk:"subuid_base"`
+	SubuidLength types.Int64  `tfsdk:"subuid_length"`
+	SubgidBase   types.Int64  `tfsdk:"subgid_base"`
+	SubgidLength types.Int64  `tfsdk:"subgid_length"`
+}
+
+var userSubidAttrTypes = map[string]attr.Type{
+	"unique_id":     types.StringType,
+	"subuid_base":   types.Int64Type,
+	"subuid_length": types.Int64Type,
+	"subgid_base":   types.Int64Type,
+	"subgid_length": types.Int64Type,
 }
 
 func (r *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -292,6 +312,34 @@ func (r *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"subids": schema.ListNestedAttribute{
+				MarkdownDescription: "Subordinate ID ranges of the user. Only available for active users, null when the server does not support subordinate IDs.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"unique_id": schema.StringAttribute{
+							MarkdownDescription: "Unique ID of the subordinate ID range",
+							Computed:            true,
+						},
+						"subuid_base": schema.Int64Attribute{
+							MarkdownDescription: "First subordinate user ID allocated",
+							Computed:            true,
+						},
+						"subuid_length": schema.Int64Attribute{
+							MarkdownDescription: "Number of subordinate user IDs allocated",
+							Computed:            true,
+						},
+						"subgid_base": schema.Int64Attribute{
+							MarkdownDescription: "First subordinate group ID allocated",
+							Computed:            true,
+						},
+						"subgid_length": schema.Int64Attribute{
+							MarkdownDescription: "Number of subordinate group IDs allocated",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -506,6 +554,54 @@ func (r *UserDataSource) ReadActiveUser(ctx context.Context, req datasource.Read
 		data.MemberOfIndirectSudoRule, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberofindirectSudorule)
 	}
 
+	subidOptArgs := ipa.SubidFindOptionalArgs{
+		All:      &all,
+		Ipaowner: data.UID.ValueStringPointer(),
+	}
+	subidRes, err := r.client.SubidFind("", &ipa.SubidFindArgs{}, &subidOptArgs)
+	subidSupported := true
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "NotFound"):
+			tflog.Debug(ctx, "[DEBUG] User has no subordinate IDs")
+		case strings.Contains(err.Error(), "unknown command 'subid_find'"):
+			// Servers older than FreeIPA 4.9 have no subordinate IDs, subids is left null.
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Subordinate IDs are not supported by the server: %s", err))
+			subidSupported = false
+		default:
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa subids of user %s: %s", data.UID.ValueString(), err))
+			return
+		}
+	}
+	subids := []UserSubidDataSourceModel{}
+	if subidSupported && subidRes != nil {
+		for _, v := range subidRes.Result {
+			subid := UserSubidDataSourceModel{
+				UniqueId: types.StringValue(v.Ipauniqueid),
+			}
+			if v.Ipasubuidnumber != nil {
+				subid.SubuidBase = types.Int64Value(int64(*v.Ipasubuidnumber))
+			}
+			if v.Ipasubuidcount != nil {
+				subid.SubuidLength = types.Int64Value(int64(*v.Ipasubuidcount))
+			}
+			if v.Ipasubgidnumber != nil {
+				subid.SubgidBase = types.Int64Value(int64(*v.Ipasubgidnumber))
+			}
+			if v.Ipasubgidcount != nil {
+				subid.SubgidLength = types.Int64Value(int64(*v.Ipasubgidcount))
+			}
+			subids = append(subids, subid)
+		}
+	}
+	if subidSupported {
+		var diag diag.Diagnostics
+		data.Subids, diag = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: userSubidAttrTypes}, subids)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+
 	data.Id = types.StringValue(data.UID.ValueString())
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
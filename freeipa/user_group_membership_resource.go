@@ -179,7 +179,7 @@ func (r *userGroupMembership) Schema(ctx context.Context, req resource.SchemaReq
 				ElementType:         types.StringType,
 			},
 			"external_members": schema.ListAttribute{
-				MarkdownDescription: "External members to add as group members. name must refer to an external group. (Requires a valid AD Trust configuration).",
+				MarkdownDescription: "External members to add as group members. name must refer to an external group. (Requires a valid AD Trust configuration, see `freeipa_trust`).",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
@@ -280,6 +280,18 @@ func (r *userGroupMembership) Create(ctx context.Context, req resource.CreateReq
 		data.Id = types.StringValue(fmt.Sprintf("%s/m/%s", data.Name.ValueString(), data.Identifier.ValueString()))
 	}
 
+	if !data.ExternalMember.IsNull() || !data.ExternalMembers.IsNull() {
+		external, err := isExternalGroup(r.client, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error looking up freeipa group %s: %s", data.Name.ValueString(), err))
+			return
+		}
+		if !external {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa user group membership: %s is not an external group. Members from a trusted domain can only be added to a group created with external = true", data.Name.ValueString()))
+			return
+		}
+	}
+
 	_v, err := r.client.GroupAddMember(&args, &optArgs)
 	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Error creating freeipa user group membership: %s", _v.String()))
 	if err != nil {
@@ -300,7 +312,8 @@ func (r *userGroupMembership) Create(ctx context.Context, req resource.CreateReq
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error looking up freeipa user group membership: %s", err))
 			return
 		}
-		if !slices.Contains(*groupRes.Result.Ipaexternalmember, data.ExternalMember.ValueString()) {
+		extMember := data.ExternalMember.ValueString()
+		if groupRes.Result.Ipaexternalmember == nil || !isStringListContainsCaseInsensistive(groupRes.Result.Ipaexternalmember, &extMember) {
 			_, err = r.client.GroupRemoveMember(&ipa.GroupRemoveMemberArgs{Cn: data.Name.ValueString()}, &ipa.GroupRemoveMemberOptionalArgs{Ipaexternalmember: &v})
 			if err != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting invalid freeipa user group membership: %s", err))
@@ -324,7 +337,7 @@ func (r *userGroupMembership) Create(ctx context.Context, req resource.CreateReq
 		for _, value := range data.ExternalMembers.Elements() {
 			val, _ := strconv.Unquote(value.String())
 			v := []string{val}
-			if !slices.Contains(*groupRes.Result.Ipaexternalmember, val) {
+			if groupRes.Result.Ipaexternalmember == nil || !isStringListContainsCaseInsensistive(groupRes.Result.Ipaexternalmember, &val) {
 				_, err = r.client.GroupRemoveMember(&ipa.GroupRemoveMemberArgs{Cn: data.Name.ValueString()}, &ipa.GroupRemoveMemberOptionalArgs{Ipaexternalmember: &v})
 				if err != nil {
 					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting invalid freeipa user group membership: %s", err))
@@ -407,7 +420,7 @@ func (r *userGroupMembership) Read(ctx context.Context, req resource.ReadRequest
 
 		if res.Result.Ipaexternalmember != nil {
 			extmembers := *res.Result.Ipaexternalmember
-			if slices.Contains(extmembers, v[0]) {
+			if isStringListContainsCaseInsensistive(&extmembers, &v[0]) {
 				data.ExternalMember = types.StringValue(v[0])
 			} else {
 				data.ExternalMember = types.StringValue("")
@@ -464,7 +477,7 @@ func (r *userGroupMembership) Read(ctx context.Context, req resource.ReadRequest
 				if err != nil {
 					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa group external member failed with error %s", err))
 				}
-				if slices.Contains(*res.Result.Ipaexternalmember, val) {
+				if isStringListContainsCaseInsensistive(res.Result.Ipaexternalmember, &val) {
 					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa group external member %s is present in results", val))
 					changedVals = append(changedVals, val)
 				}
@@ -591,6 +604,17 @@ func (r *userGroupMembership) Update(ctx context.Context, req resource.UpdateReq
 		}
 
 	}
+	if memberAddOptArgs.Ipaexternalmember != nil {
+		external, err := isExternalGroup(r.client, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error looking up freeipa group %s: %s", data.Name.ValueString(), err))
+			return
+		}
+		if !external {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa user group membership: %s is not an external group. Members from a trusted domain can only be added to a group created with external = true", data.Name.ValueString()))
+			return
+		}
+	}
 	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
 	if hasMemberAdd {
 		_v, err := r.client.GroupAddMember(&memberAddArgs, &memberAddOptArgs)
@@ -613,7 +637,7 @@ func (r *userGroupMembership) Update(ctx context.Context, req resource.UpdateReq
 			}
 			for _, value := range *memberAddOptArgs.Ipaexternalmember {
 				v := []string{value}
-				if !slices.Contains(*groupRes.Result.Ipaexternalmember, value) {
+				if groupRes.Result.Ipaexternalmember == nil || !isStringListContainsCaseInsensistive(groupRes.Result.Ipaexternalmember, &value) {
 					_, err = r.client.GroupRemoveMember(&ipa.GroupRemoveMemberArgs{Cn: data.Name.ValueString()}, &ipa.GroupRemoveMemberOptionalArgs{Ipaexternalmember: &v})
 					if err != nil {
 						resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting invalid freeipa user group membership: %s", err))
@@ -723,3 +747,18 @@ func parseUserMembershipID(id string) (string, string, string, error) {
 
 	return name, _type, user, nil
 }
+
+// isExternalGroup returns true if the group can contain members from a trusted domain.
+func isExternalGroup(client *ipa.Client, name string) (bool, error) {
+	valTrue := true
+	res, err := client.GroupFind(name, &ipa.GroupFindArgs{}, &ipa.GroupFindOptionalArgs{External: &valTrue, Cn: &name})
+	if err != nil {
+		return false, err
+	}
+	for _, grp := range res.Result {
+		if grp.Cn == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -6,6 +6,7 @@
 package freeipa
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -297,3 +298,28 @@ func TestAccFreeIPAUserGroupMembership_multiple_posix_CaseInsensitive(t *testing
 		},
 	})
 }
+
+func TestAccFreeIPAUserGroupMembership_externalMembersNotExternalGroup(t *testing.T) {
+	testGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-group-0\"",
+		"description": "\"User group test 0\"",
+	}
+	testMembership := map[string]string{
+		"index":            "0",
+		"name":             "freeipa_group.group-0.name",
+		"external_members": "[\"domain admins@ad.testacc.lan\"]",
+		"identifier":       "\"external\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAUserGroupMembership_resource(testMembership),
+				ExpectError: regexp.MustCompile("testacc-group-0 is not an external group"),
+			},
+		},
+	})
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -188,7 +189,7 @@ func userSchema() schema.Schema {
 				Optional:            true,
 			},
 			"auth_type": schema.SetAttribute{
-				MarkdownDescription: "User authentication type. Possible values of the elements are (password, radius, otp, pkinit, hardened, idp, passkey)",
+				MarkdownDescription: "User authentication type. Possible values of the elements are (password, radius, otp, pkinit, hardened, idp, passkey). OTP tokens are managed with the `freeipa_otp_token` resource.",
 				Optional:            true,
 				ElementType:         types.StringType,
 				Validators: []validator.Set{
@@ -196,7 +197,7 @@ func userSchema() schema.Schema {
 				},
 			},
 			"radius_proxy_config": schema.StringAttribute{
-				MarkdownDescription: "RADIUS proxy configuration",
+				MarkdownDescription: "RADIUS proxy configuration, the name of a `freeipa_radius_proxy` resource",
 				Optional:            true,
 			},
 			"radius_proxy_username": schema.StringAttribute{
@@ -204,7 +205,7 @@ func userSchema() schema.Schema {
 				Optional:            true,
 			},
 			"external_idp_config": schema.StringAttribute{
-				MarkdownDescription: "External IdP configuration",
+				MarkdownDescription: "External IdP configuration, the name of a `freeipa_idp` resource",
 				Optional:            true,
 			},
 			"external_idp_username": schema.StringAttribute{
@@ -389,6 +390,17 @@ func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// warn when the uid or gid is not part of any local id range
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if !data.UidNumber.IsNull() && !data.UidNumber.IsUnknown() && !data.UidNumber.Equal(state.UidNumber) {
+		resp.Diagnostics.Append(checkIdInLocalIdRanges(ctx, r.client, path.Root("uid_number"), int64(data.UidNumber.ValueInt32()))...)
+	}
+	if !data.GidNumber.IsNull() && !data.GidNumber.IsUnknown() && !data.GidNumber.Equal(state.GidNumber) {
+		resp.Diagnostics.Append(checkIdInLocalIdRanges(ctx, r.client, path.Root("gid_number"), int64(data.GidNumber.ValueInt32()))...)
+	}
+
 	if data.State.Equal(types.StringValue("active")) {
 		if data.AccountDisabled.ValueBool() {
 			data.State = types.StringValue("disabled")
@@ -411,7 +423,6 @@ func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 		return
 	}
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if data.State.Equal(types.StringValue("preserved")) { // to preserved
 		if state.State.Equal(types.StringValue("staged")) {
 			resp.Diagnostics.AddError("User Lifecycle", "Preserving a staged user is not allowed.")
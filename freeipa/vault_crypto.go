@@ -0,0 +1,339 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// The vault payloads are encrypted on the client side, exactly like the ipa command line does:
+// the payload is wrapped with a session key that is itself encrypted with the KRA transport certificate.
+// Symmetric vaults additionally encrypt the data with a key derived from the vault password (Fernet),
+// asymmetric vaults with the vault public key (RSA OAEP).
+// Binary values are exchanged base64 encoded with the api.
+
+// vaultScope identifies the container of a vault: a user (the bind user when empty), a service or the shared container.
+type vaultScope struct {
+	Username *string
+	Service  *string
+	Shared   *bool
+}
+
+type vaultJsonData struct {
+	Data string `json:"data"`
+}
+
+type vaultWrappingAlgo struct {
+	name      string
+	keySize   int
+	newCipher func(key []byte) (cipher.Block, error)
+}
+
+var vaultWrappingAlgos = []vaultWrappingAlgo{
+	{name: "aes-128-cbc", keySize: 16, newCipher: aes.NewCipher},
+	{name: "des-ede3-cbc", keySize: 24, newCipher: des.NewTripleDESCipher},
+}
+
+// vaultTransport returns the transport public key of the KRA and the strongest wrapping algorithm supported by the server.
+func vaultTransport(client *ipa.Client) (*rsa.PublicKey, *vaultWrappingAlgo, error) {
+	res, err := client.VaultconfigShow(&ipa.VaultconfigShowArgs{}, &ipa.VaultconfigShowOptionalArgs{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading freeipa vault config: %s", err)
+	}
+	der, err := base64.StdEncoding.DecodeString(res.Result.TransportCert)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding the KRA transport certificate: %s", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing the KRA transport certificate: %s", err)
+	}
+	pub, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, nil, errors.New("the KRA transport certificate does not hold a RSA public key")
+	}
+	// Servers that do not report the supported algorithms only support 3DES.
+	algo := &vaultWrappingAlgos[1]
+	if res.Result.WrappingSupportedAlgorithms != nil {
+		for i := range vaultWrappingAlgos {
+			if slices.Contains(*res.Result.WrappingSupportedAlgorithms, vaultWrappingAlgos[i].name) {
+				algo = &vaultWrappingAlgos[i]
+				break
+			}
+		}
+	}
+	return pub, algo, nil
+}
+
+// vaultArchive encrypts and stores data in a vault, replacing the previous payload.
+func vaultArchive(client *ipa.Client, name string, scope vaultScope, data []byte) error {
+	pub, algo, err := vaultTransport(client)
+	if err != nil {
+		return err
+	}
+	sessionKey, wrappedSessionKey, err := vaultWrapSessionKey(pub, algo)
+	if err != nil {
+		return err
+	}
+	nonce, vaultData, err := vaultWrapData(algo, sessionKey, data)
+	if err != nil {
+		return err
+	}
+
+	algoName := algo.name
+	optArgs := ipa.VaultArchiveInternalOptionalArgs{
+		WrappingAlgo: &algoName,
+		Username:     scope.Username,
+		Service:      scope.Service,
+		Shared:       scope.Shared,
+	}
+	args := ipa.VaultArchiveInternalArgs{
+		Cn:         name,
+		SessionKey: base64.StdEncoding.EncodeToString(wrappedSessionKey),
+		VaultData:  base64.StdEncoding.EncodeToString(vaultData),
+		Nonce:      base64.StdEncoding.EncodeToString(nonce),
+	}
+	_, err = client.VaultArchiveInternal(&args, &optArgs)
+	if err != nil {
+		return fmt.Errorf("error archiving freeipa vault data: %s", err)
+	}
+	return nil
+}
+
+// vaultRetrieve retrieves and decrypts the payload of a vault, as archived by vaultArchive.
+func vaultRetrieve(client *ipa.Client, name string, scope vaultScope) ([]byte, error) {
+	pub, algo, err := vaultTransport(client)
+	if err != nil {
+		return nil, err
+	}
+	sessionKey, wrappedSessionKey, err := vaultWrapSessionKey(pub, algo)
+	if err != nil {
+		return nil, err
+	}
+
+	algoName := algo.name
+	optArgs := ipa.VaultRetrieveInternalOptionalArgs{
+		WrappingAlgo: &algoName,
+		Username:     scope.Username,
+		Service:      scope.Service,
+		Shared:       scope.Shared,
+	}
+	args := ipa.VaultRetrieveInternalArgs{
+		Cn:         name,
+		SessionKey: base64.StdEncoding.EncodeToString(wrappedSessionKey),
+	}
+	res, err := client.VaultRetrieveInternal(&args, &optArgs)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving freeipa vault data: %s", err)
+	}
+	vaultData, err := base64.StdEncoding.DecodeString(res.Result.VaultData)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := base64.StdEncoding.DecodeString(res.Result.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return vaultUnwrapData(algo, sessionKey, nonce, vaultData)
+}
+
+// vaultWrapSessionKey generates a random session key for the wrapping algorithm
+// and encrypts it with the KRA transport public key.
+func vaultWrapSessionKey(pub *rsa.PublicKey, algo *vaultWrappingAlgo) ([]byte, []byte, error) {
+	sessionKey := make([]byte, algo.keySize)
+	if _, err := rand.Read(sessionKey); err != nil {
+		return nil, nil, err
+	}
+	wrappedSessionKey, err := rsa.EncryptPKCS1v15(rand.Reader, pub, sessionKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error wrapping the session key: %s", err)
+	}
+	return sessionKey, wrappedSessionKey, nil
+}
+
+// vaultWrapData encrypts the payload with the session key, as expected by vault_archive_internal.
+func vaultWrapData(algo *vaultWrappingAlgo, sessionKey []byte, data []byte) ([]byte, []byte, error) {
+	jsonData, err := json.Marshal(vaultJsonData{Data: base64.StdEncoding.EncodeToString(data)})
+	if err != nil {
+		return nil, nil, err
+	}
+	block, err := algo.newCipher(sessionKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cbcEncrypt(block, jsonData)
+}
+
+// vaultUnwrapData decrypts the payload returned by vault_retrieve_internal with the session key.
+func vaultUnwrapData(algo *vaultWrappingAlgo, sessionKey []byte, nonce []byte, vaultData []byte) ([]byte, error) {
+	block, err := algo.newCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	jsonData, err := cbcDecrypt(block, nonce, vaultData)
+	if err != nil {
+		return nil, err
+	}
+	var v vaultJsonData
+	if err := json.Unmarshal(jsonData, &v); err != nil {
+		return nil, fmt.Errorf("error decoding freeipa vault data: %s", err)
+	}
+	return base64.StdEncoding.DecodeString(v.Data)
+}
+
+// vaultEncrypt encrypts data before archiving it, according to the type of the vault.
+func vaultEncrypt(vaultType string, data []byte, password string, salt []byte, publicKey []byte) ([]byte, error) {
+	switch vaultType {
+	case "symmetric":
+		key, err := vaultSymmetricKey(password, salt)
+		if err != nil {
+			return nil, err
+		}
+		return fernetEncrypt(key, data)
+	case "asymmetric":
+		p, _ := pem.Decode(publicKey)
+		if p == nil {
+			return nil, errors.New("the public key of the vault is not PEM encoded")
+		}
+		pub, err := x509.ParsePKIXPublicKey(p.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the public key of the vault: %s", err)
+		}
+		rsaPub, ok := pub.(*rsa.PublicKey)
+		if !ok {
+			return nil, errors.New("the public key of the vault is not a RSA public key")
+		}
+		return rsa.EncryptOAEP(sha1.New(), rand.Reader, rsaPub, data, nil)
+	}
+	return data, nil
+}
+
+// vaultDecrypt decrypts data after retrieving it, according to the type of the vault.
+func vaultDecrypt(vaultType string, data []byte, password string, salt []byte, privateKey []byte) ([]byte, error) {
+	switch vaultType {
+	case "symmetric":
+		key, err := vaultSymmetricKey(password, salt)
+		if err != nil {
+			return nil, err
+		}
+		return fernetDecrypt(key, data)
+	case "asymmetric":
+		p, _ := pem.Decode(privateKey)
+		if p == nil {
+			return nil, errors.New("the private key is not PEM encoded")
+		}
+		var rsaKey *rsa.PrivateKey
+		key, err := x509.ParsePKCS8PrivateKey(p.Bytes)
+		if err == nil {
+			var ok bool
+			rsaKey, ok = key.(*rsa.PrivateKey)
+			if !ok {
+				return nil, errors.New("the private key is not a RSA private key")
+			}
+		} else {
+			rsaKey, err = x509.ParsePKCS1PrivateKey(p.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing the private key: %s", err)
+			}
+		}
+		return rsa.DecryptOAEP(sha1.New(), rand.Reader, rsaKey, data, nil)
+	}
+	return data, nil
+}
+
+// vaultSymmetricKey derives the encryption key of a symmetric vault from its password and salt.
+func vaultSymmetricKey(password string, salt []byte) ([]byte, error) {
+	return pbkdf2.Key(sha256.New, password, salt, 100000, 32)
+}
+
+// fernetEncrypt implements the Fernet specification used by the ipa command line for symmetric vaults.
+func fernetEncrypt(key []byte, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key[16:])
+	if err != nil {
+		return nil, err
+	}
+	iv, ciphertext, err := cbcEncrypt(block, data)
+	if err != nil {
+		return nil, err
+	}
+	token := []byte{0x80}
+	token = binary.BigEndian.AppendUint64(token, uint64(time.Now().Unix()))
+	token = append(token, iv...)
+	token = append(token, ciphertext...)
+	mac := hmac.New(sha256.New, key[:16])
+	mac.Write(token)
+	token = mac.Sum(token)
+	return []byte(base64.URLEncoding.EncodeToString(token)), nil
+}
+
+// fernetDecrypt verifies and decrypts a Fernet token, the verification fails when the password is invalid.
+func fernetDecrypt(key []byte, data []byte) ([]byte, error) {
+	token, err := base64.URLEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+	if len(token) < 1+8+aes.BlockSize+sha256.Size || token[0] != 0x80 {
+		return nil, errors.New("invalid vault data")
+	}
+	payload, sum := token[:len(token)-sha256.Size], token[len(token)-sha256.Size:]
+	mac := hmac.New(sha256.New, key[:16])
+	mac.Write(payload)
+	if !hmac.Equal(sum, mac.Sum(nil)) {
+		return nil, errors.New("invalid vault password")
+	}
+	block, err := aes.NewCipher(key[16:])
+	if err != nil {
+		return nil, err
+	}
+	return cbcDecrypt(block, payload[9:9+aes.BlockSize], payload[9+aes.BlockSize:])
+}
+
+// cbcEncrypt pads data with PKCS#7 and encrypts it in CBC mode with a random IV.
+func cbcEncrypt(block cipher.Block, data []byte) ([]byte, []byte, error) {
+	iv := make([]byte, block.BlockSize())
+	if _, err := rand.Read(iv); err != nil {
+		return nil, nil, err
+	}
+	padding := block.BlockSize() - len(data)%block.BlockSize()
+	padded := append(bytes.Clone(data), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	ciphertext := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, padded)
+	return iv, ciphertext, nil
+}
+
+// cbcDecrypt decrypts data in CBC mode and removes the PKCS#7 padding.
+func cbcDecrypt(block cipher.Block, iv []byte, data []byte) ([]byte, error) {
+	if len(iv) != block.BlockSize() || len(data) == 0 || len(data)%block.BlockSize() != 0 {
+		return nil, errors.New("invalid encrypted vault data")
+	}
+	plaintext := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, data)
+	padding := int(plaintext[len(plaintext)-1])
+	if padding == 0 || padding > block.BlockSize() {
+		return nil, errors.New("invalid padding of the vault data")
+	}
+	return plaintext[:len(plaintext)-padding], nil
+}
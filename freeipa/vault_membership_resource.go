@@ -0,0 +1,822 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VaultMembershipResource{}
+var _ resource.ResourceWithImportState = &VaultMembershipResource{}
+
+func NewVaultMembershipResource() resource.Resource {
+	return &VaultMembershipResource{}
+}
+
+// VaultMembershipResource defines the resource implementation.
+type VaultMembershipResource struct {
+	client *ipa.Client
+}
+
+// VaultMembershipResourceModel describes the resource data model.
+type VaultMembershipResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Username       types.String `tfsdk:"username"`
+	Service        types.String `tfsdk:"service"`
+	Shared         types.Bool   `tfsdk:"shared"`
+	OwnerUsers     types.List   `tfsdk:"owner_users"`
+	OwnerGroups    types.List   `tfsdk:"owner_groups"`
+	OwnerServices  types.List   `tfsdk:"owner_services"`
+	MemberUsers    types.List   `tfsdk:"member_users"`
+	MemberGroups   types.List   `tfsdk:"member_groups"`
+	MemberServices types.List   `tfsdk:"member_services"`
+	Identifier     types.String `tfsdk:"identifier"`
+}
+
+func (r *VaultMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_membership"
+}
+
+func (r *VaultMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("owner_users"),
+			path.MatchRoot("owner_groups"),
+			path.MatchRoot("owner_services"),
+			path.MatchRoot("member_users"),
+			path.MatchRoot("member_groups"),
+			path.MatchRoot("member_services"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("username"),
+			path.MatchRoot("service"),
+			path.MatchRoot("shared"),
+		),
+	}
+}
+
+func (r *VaultMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA vault membership resource.\nOwners can manage the vault and its members, members can archive and retrieve the payload of the vault.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the vault",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Login of the user owning the vault",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Principal of the service owning the vault",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shared": schema.BoolAttribute{
+				MarkdownDescription: "The vault is in the shared container",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_users": schema.ListAttribute{
+				MarkdownDescription: "List of users owning the vault",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"owner_groups": schema.ListAttribute{
+				MarkdownDescription: "List of user groups owning the vault",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"owner_services": schema.ListAttribute{
+				MarkdownDescription: "List of services owning the vault",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"member_users": schema.ListAttribute{
+				MarkdownDescription: "List of users member of the vault",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"member_groups": schema.ListAttribute{
+				MarkdownDescription: "List of user groups member of the vault",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"member_services": schema.ListAttribute{
+				MarkdownDescription: "List of services member of the vault",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple vault membership resources on the same vault.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VaultMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VaultMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VaultMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+	ownerOptArgs := ipa.VaultAddOwnerOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	memberOptArgs := ipa.VaultAddMemberOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	hasOwner := false
+	hasMember := false
+	if !data.OwnerUsers.IsNull() {
+		var v []string
+		for _, value := range data.OwnerUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		ownerOptArgs.User = &v
+		hasOwner = true
+	}
+	if !data.OwnerGroups.IsNull() {
+		var v []string
+		for _, value := range data.OwnerGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		ownerOptArgs.Group = &v
+		hasOwner = true
+	}
+	if !data.OwnerServices.IsNull() {
+		var v []string
+		for _, value := range data.OwnerServices.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		ownerOptArgs.Services = &v
+		hasOwner = true
+	}
+	if !data.MemberUsers.IsNull() {
+		var v []string
+		for _, value := range data.MemberUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		memberOptArgs.User = &v
+		hasMember = true
+	}
+	if !data.MemberGroups.IsNull() {
+		var v []string
+		for _, value := range data.MemberGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		memberOptArgs.Group = &v
+		hasMember = true
+	}
+	if !data.MemberServices.IsNull() {
+		var v []string
+		for _, value := range data.MemberServices.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		memberOptArgs.Services = &v
+		hasMember = true
+	}
+	if hasOwner {
+		_v, err := r.client.VaultAddOwner(&ipa.VaultAddOwnerArgs{Cn: data.Name.ValueString()}, &ownerOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa vault owner membership: %s", err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa vault owner membership: %v", _v.Failed))
+		}
+	}
+	if hasMember {
+		_v, err := r.client.VaultAddMember(&ipa.VaultAddMemberArgs{Cn: data.Name.ValueString()}, &memberOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa vault member membership: %s", err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa vault member membership: %v", _v.Failed))
+		}
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/vm/%s", vaultId(data.Name.ValueString(), scope), data.Identifier.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VaultMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VaultMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+	all := true
+	optArgs := ipa.VaultShowOptionalArgs{
+		All:      &all,
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+
+	args := ipa.VaultShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	res, err := r.client.VaultShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Vault not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa vault: %s", err))
+			return
+		}
+	}
+
+	if !data.OwnerUsers.IsNull() {
+		var changedVals []string
+		for _, value := range data.OwnerUsers.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership owner_users failed with error %s", err))
+			}
+			if res.Result.OwnerUser != nil && isStringListContainsCaseInsensistive(res.Result.OwnerUser, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership owner_users %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.OwnerUsers, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.OwnerGroups.IsNull() {
+		var changedVals []string
+		for _, value := range data.OwnerGroups.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership owner_groups failed with error %s", err))
+			}
+			if res.Result.OwnerGroup != nil && isStringListContainsCaseInsensistive(res.Result.OwnerGroup, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership owner_groups %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.OwnerGroups, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.OwnerServices.IsNull() {
+		var changedVals []string
+		for _, value := range data.OwnerServices.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership owner_services failed with error %s", err))
+			}
+			if res.Result.OwnerService != nil && isStringListContainsCaseInsensistive(res.Result.OwnerService, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership owner_services %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.OwnerServices, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.MemberUsers.IsNull() {
+		var changedVals []string
+		for _, value := range data.MemberUsers.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership member_users failed with error %s", err))
+			}
+			if res.Result.MemberUser != nil && isStringListContainsCaseInsensistive(res.Result.MemberUser, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership member_users %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.MemberUsers, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.MemberGroups.IsNull() {
+		var changedVals []string
+		for _, value := range data.MemberGroups.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership member_groups failed with error %s", err))
+			}
+			if res.Result.MemberGroup != nil && isStringListContainsCaseInsensistive(res.Result.MemberGroup, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership member_groups %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.MemberGroups, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.MemberServices.IsNull() {
+		var changedVals []string
+		for _, value := range data.MemberServices.Elements() {
+			val, err := strconv.Unquote(value.String())
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership member_services failed with error %s", err))
+			}
+			if res.Result.MemberService != nil && isStringListContainsCaseInsensistive(res.Result.MemberService, &val) {
+				tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault membership member_services %s is present in results", val))
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.MemberServices, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VaultMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state VaultMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+	ownerAddOptArgs := ipa.VaultAddOwnerOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	ownerRemoveOptArgs := ipa.VaultRemoveOwnerOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	memberAddOptArgs := ipa.VaultAddMemberOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	memberRemoveOptArgs := ipa.VaultRemoveMemberOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	hasOwnerAdd := false
+	hasOwnerRemove := false
+	hasMemberAdd := false
+	hasMemberRemove := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.OwnerUsers.Equal(state.OwnerUsers) {
+		var statearr, planarr, added, deleted []string
+
+		for _, value := range state.OwnerUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.OwnerUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				added = append(added, val)
+				ownerAddOptArgs.User = &added
+				hasOwnerAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deleted = append(deleted, value)
+				ownerRemoveOptArgs.User = &deleted
+				hasOwnerRemove = true
+			}
+		}
+	}
+	if !data.OwnerGroups.Equal(state.OwnerGroups) {
+		var statearr, planarr, added, deleted []string
+
+		for _, value := range state.OwnerGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.OwnerGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				added = append(added, val)
+				ownerAddOptArgs.Group = &added
+				hasOwnerAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deleted = append(deleted, value)
+				ownerRemoveOptArgs.Group = &deleted
+				hasOwnerRemove = true
+			}
+		}
+	}
+	if !data.OwnerServices.Equal(state.OwnerServices) {
+		var statearr, planarr, added, deleted []string
+
+		for _, value := range state.OwnerServices.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.OwnerServices.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				added = append(added, val)
+				ownerAddOptArgs.Services = &added
+				hasOwnerAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deleted = append(deleted, value)
+				ownerRemoveOptArgs.Services = &deleted
+				hasOwnerRemove = true
+			}
+		}
+	}
+	if !data.MemberUsers.Equal(state.MemberUsers) {
+		var statearr, planarr, added, deleted []string
+
+		for _, value := range state.MemberUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.MemberUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				added = append(added, val)
+				memberAddOptArgs.User = &added
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deleted = append(deleted, value)
+				memberRemoveOptArgs.User = &deleted
+				hasMemberRemove = true
+			}
+		}
+	}
+	if !data.MemberGroups.Equal(state.MemberGroups) {
+		var statearr, planarr, added, deleted []string
+
+		for _, value := range state.MemberGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.MemberGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				added = append(added, val)
+				memberAddOptArgs.Group = &added
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deleted = append(deleted, value)
+				memberRemoveOptArgs.Group = &deleted
+				hasMemberRemove = true
+			}
+		}
+	}
+	if !data.MemberServices.Equal(state.MemberServices) {
+		var statearr, planarr, added, deleted []string
+
+		for _, value := range state.MemberServices.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.MemberServices.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				added = append(added, val)
+				memberAddOptArgs.Services = &added
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deleted = append(deleted, value)
+				memberRemoveOptArgs.Services = &deleted
+				hasMemberRemove = true
+			}
+		}
+	}
+	// The api provides a add and a remove function for owners and members. Therefore we need to call the right one when appropriate.
+	if hasOwnerAdd {
+		_v, err := r.client.VaultAddOwner(&ipa.VaultAddOwnerArgs{Cn: data.Name.ValueString()}, &ownerAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa vault owner membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Create freeipa vault owner membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa vault owner membership: %v", _v.Failed))
+		}
+	}
+	if hasOwnerRemove {
+		_v, err := r.client.VaultRemoveOwner(&ipa.VaultRemoveOwnerArgs{Cn: data.Name.ValueString()}, &ownerRemoveOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa vault owner membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Remove freeipa vault owner membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa vault owner membership: %v", _v.Failed))
+			return
+		}
+	}
+	if hasMemberAdd {
+		_v, err := r.client.VaultAddMember(&ipa.VaultAddMemberArgs{Cn: data.Name.ValueString()}, &memberAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa vault member membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Create freeipa vault member membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa vault member membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberRemove {
+		_v, err := r.client.VaultRemoveMember(&ipa.VaultRemoveMemberArgs{Cn: data.Name.ValueString()}, &memberRemoveOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa vault member membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Remove freeipa vault member membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa vault member membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VaultMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VaultMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+	ownerOptArgs := ipa.VaultRemoveOwnerOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	memberOptArgs := ipa.VaultRemoveMemberOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	hasOwner := false
+	hasMember := false
+	if !data.OwnerUsers.IsNull() {
+		var v []string
+		for _, value := range data.OwnerUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		ownerOptArgs.User = &v
+		hasOwner = true
+	}
+	if !data.OwnerGroups.IsNull() {
+		var v []string
+		for _, value := range data.OwnerGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		ownerOptArgs.Group = &v
+		hasOwner = true
+	}
+	if !data.OwnerServices.IsNull() {
+		var v []string
+		for _, value := range data.OwnerServices.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		ownerOptArgs.Services = &v
+		hasOwner = true
+	}
+	if !data.MemberUsers.IsNull() {
+		var v []string
+		for _, value := range data.MemberUsers.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		memberOptArgs.User = &v
+		hasMember = true
+	}
+	if !data.MemberGroups.IsNull() {
+		var v []string
+		for _, value := range data.MemberGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		memberOptArgs.Group = &v
+		hasMember = true
+	}
+	if !data.MemberServices.IsNull() {
+		var v []string
+		for _, value := range data.MemberServices.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		memberOptArgs.Services = &v
+		hasMember = true
+	}
+	if hasOwner {
+		_, err := r.client.VaultRemoveOwner(&ipa.VaultRemoveOwnerArgs{Cn: data.Name.ValueString()}, &ownerOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa vault owner membership: %s", err))
+			return
+		}
+	}
+	if hasMember {
+		_, err := r.client.VaultRemoveMember(&ipa.VaultRemoveMemberArgs{Cn: data.Name.ValueString()}, &memberOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa vault member membership: %s", err))
+			return
+		}
+	}
+}
+
+func (r *VaultMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vaultIdPart, identifier, found := strings.Cut(req.ID, "/vm/")
+	if !found || identifier == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("The ID %q must use the format <vault id>/vm/<identifier>.", req.ID))
+		return
+	}
+	name, scope, err := parseVaultId(vaultIdPart)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.VaultShowOptionalArgs{
+		All:      &all,
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	res, err := r.client.VaultShow(&ipa.VaultShowArgs{Cn: name}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Vault not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa vault: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), identifier)...)
+	if scope.Username != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), *scope.Username)...)
+	}
+	if scope.Service != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), *scope.Service)...)
+	}
+	if scope.Shared != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("shared"), *scope.Shared)...)
+	}
+	if res.Result.OwnerUser != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_users"), res.Result.OwnerUser)...)
+	}
+	if res.Result.OwnerGroup != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_groups"), res.Result.OwnerGroup)...)
+	}
+	if res.Result.OwnerService != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_services"), res.Result.OwnerService)...)
+	}
+	if res.Result.MemberUser != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_users"), res.Result.MemberUser)...)
+	}
+	if res.Result.MemberGroup != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_groups"), res.Result.MemberGroup)...)
+	}
+	if res.Result.MemberService != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_services"), res.Result.MemberService)...)
+	}
+}
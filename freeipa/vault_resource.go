@@ -0,0 +1,505 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VaultResource{}
+var _ resource.ResourceWithImportState = &VaultResource{}
+var _ resource.ResourceWithConfigValidators = &VaultResource{}
+
+func NewVaultResource() resource.Resource {
+	return &VaultResource{}
+}
+
+// VaultResource defines the resource implementation.
+type VaultResource struct {
+	client *ipa.Client
+}
+
+// VaultResourceModel describes the resource data model.
+type VaultResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	Username      types.String `tfsdk:"username"`
+	Service       types.String `tfsdk:"service"`
+	Shared        types.Bool   `tfsdk:"shared"`
+	PublicKey     types.String `tfsdk:"public_key"`
+	PasswordWo    types.String `tfsdk:"password_wo"`
+	DataWo        types.String `tfsdk:"data_wo"`
+	DataWoVersion types.Int64  `tfsdk:"data_wo_version"`
+}
+
+func (r *VaultResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault"
+}
+
+func (r *VaultResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("username"),
+			path.MatchRoot("service"),
+			path.MatchRoot("shared"),
+		),
+	}
+}
+
+func (r *VaultResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA vault resource.\n" +
+			"A vault belongs to a user (`username`, the provider user when not set), a service (`service`) or is shared (`shared`).\n\n" +
+			"The payload of the vault is encrypted on the provider side and archived from the write-only attribute `data_wo`, it is never stored in the state. " +
+			"Use the `freeipa_vault_secret` ephemeral resource to retrieve it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Vault name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Vault description",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Vault type (`standard`, `symmetric` or `asymmetric`). Defaults to `standard`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("standard"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("standard", "symmetric", "asymmetric"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Login of the user owning the vault",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Principal of the service owning the vault (ie: `HTTP/web.example.lan`)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shared": schema.BoolAttribute{
+				MarkdownDescription: "Create the vault in the shared container",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded RSA public key used to encrypt the payload of an `asymmetric` vault",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password used to encrypt the payload of a `symmetric` vault. This attribute is write-only and never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"data_wo": schema.StringAttribute{
+				MarkdownDescription: "Payload archived in the vault. This attribute is write-only and never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"data_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the payload. Change it to archive a new `data_wo` in the vault.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *VaultResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VaultResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config VaultResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.ValueString() == "symmetric" && config.PasswordWo.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Missing vault password", "A symmetric vault requires a password.")
+	}
+	if data.Type.ValueString() == "asymmetric" && data.PublicKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Missing vault public key", "An asymmetric vault requires a public key.")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+	optArgs := ipa.VaultAddInternalOptionalArgs{
+		Ipavaulttype: data.Type.ValueStringPointer(),
+		Username:     scope.Username,
+		Service:      scope.Service,
+		Shared:       scope.Shared,
+	}
+
+	args := ipa.VaultAddInternalArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	var salt []byte
+	if data.Type.ValueString() == "symmetric" {
+		salt = make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error generating freeipa vault salt: %s", err))
+			return
+		}
+		v := base64.StdEncoding.EncodeToString(salt)
+		optArgs.Ipavaultsalt = &v
+	}
+	if !data.PublicKey.IsNull() {
+		v := base64.StdEncoding.EncodeToString([]byte(data.PublicKey.ValueString()))
+		optArgs.Ipavaultpublickey = &v
+	}
+
+	_, err := r.client.VaultAddInternal(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa vault: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(vaultId(data.Name.ValueString(), scope))
+
+	// Like the ipa command line, an empty payload is archived when no data is provided to initialize the vault.
+	payload, err := vaultEncrypt(data.Type.ValueString(), []byte(config.DataWo.ValueString()), config.PasswordWo.ValueString(), salt, []byte(data.PublicKey.ValueString()))
+	if err == nil {
+		err = vaultArchive(r.client, data.Name.ValueString(), scope, payload)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error archiving freeipa vault data: %s", err))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VaultResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VaultResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+	all := true
+	optArgs := ipa.VaultShowOptionalArgs{
+		All:      &all,
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+
+	args := ipa.VaultShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	res, err := r.client.VaultShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Vault not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa vault: %s", err))
+			return
+		}
+	}
+
+	data.Name = types.StringValue(res.Result.Cn)
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipavaulttype != nil {
+		data.Type = types.StringValue(*res.Result.Ipavaulttype)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VaultResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state, config VaultResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+
+	if !data.Description.Equal(state.Description) {
+		optArgs := ipa.VaultModInternalOptionalArgs{
+			Username: scope.Username,
+			Service:  scope.Service,
+			Shared:   scope.Shared,
+		}
+		if data.Description.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Description = &v
+		} else {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		_, err := r.client.VaultModInternal(&ipa.VaultModInternalArgs{Cn: data.Name.ValueString()}, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa vault: %s", err))
+				return
+			}
+		}
+	}
+
+	// The payload is never read back, it is only archived when its version changes.
+	if !data.DataWoVersion.Equal(state.DataWoVersion) {
+		var salt []byte
+		if data.Type.ValueString() == "symmetric" {
+			if config.PasswordWo.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Missing vault password", "The password of the symmetric vault is required to archive a new payload.")
+				return
+			}
+			all := true
+			optArgs := ipa.VaultShowOptionalArgs{
+				All:      &all,
+				Username: scope.Username,
+				Service:  scope.Service,
+				Shared:   scope.Shared,
+			}
+			res, err := r.client.VaultShow(&ipa.VaultShowArgs{Cn: data.Name.ValueString()}, &optArgs)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa vault: %s", err))
+				return
+			}
+			if res.Result.Ipavaultsalt != nil {
+				salt, err = base64.StdEncoding.DecodeString(*res.Result.Ipavaultsalt)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error decoding freeipa vault salt: %s", err))
+					return
+				}
+			}
+		}
+		payload, err := vaultEncrypt(data.Type.ValueString(), []byte(config.DataWo.ValueString()), config.PasswordWo.ValueString(), salt, []byte(data.PublicKey.ValueString()))
+		if err == nil {
+			err = vaultArchive(r.client, data.Name.ValueString(), scope, payload)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error archiving freeipa vault data: %s", err))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(vaultId(data.Name.ValueString(), scope))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VaultResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VaultResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+	optArgs := ipa.VaultDelOptionalArgs{
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	args := ipa.VaultDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.VaultDel(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa vault: %s", err))
+		return
+	}
+}
+
+func (r *VaultResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, scope, err := parseVaultId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.VaultShowOptionalArgs{
+		All:      &all,
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	res, err := r.client.VaultShow(&ipa.VaultShowArgs{Cn: name}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa vault: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	if scope.Username != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), *scope.Username)...)
+	}
+	if scope.Service != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), *scope.Service)...)
+	}
+	if scope.Shared != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("shared"), *scope.Shared)...)
+	}
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), *res.Result.Description)...)
+	}
+	if res.Result.Ipavaulttype != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), *res.Result.Ipavaulttype)...)
+	}
+	if res.Result.Ipavaultpublickey != nil {
+		publicKey, err := base64.StdEncoding.DecodeString(*res.Result.Ipavaultpublickey)
+		if err == nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_key"), string(publicKey))...)
+		}
+	}
+}
+
+// vaultScopeFromModel returns the container of a vault from the username, service and shared attributes.
+func vaultScopeFromModel(username types.String, service types.String, shared types.Bool) vaultScope {
+	scope := vaultScope{}
+	if !username.IsNull() {
+		scope.Username = username.ValueStringPointer()
+	}
+	if !service.IsNull() {
+		scope.Service = service.ValueStringPointer()
+	}
+	if shared.ValueBool() {
+		scope.Shared = shared.ValueBoolPointer()
+	}
+	return scope
+}
+
+// vaultId returns the ID of a vault: <name>/shared, <name>/service/<principal>, <name>/user/<username> or <name>/user for a vault of the provider user.
+func vaultId(name string, scope vaultScope) string {
+	switch {
+	case scope.Shared != nil && *scope.Shared:
+		return fmt.Sprintf("%s/shared", encodeSlash(name))
+	case scope.Service != nil:
+		return fmt.Sprintf("%s/service/%s", encodeSlash(name), encodeSlash(*scope.Service))
+	case scope.Username != nil:
+		return fmt.Sprintf("%s/user/%s", encodeSlash(name), encodeSlash(*scope.Username))
+	}
+	return fmt.Sprintf("%s/user", encodeSlash(name))
+}
+
+func parseVaultId(id string) (string, vaultScope, error) {
+	scope := vaultScope{}
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 || len(idParts) > 3 {
+		return "", scope, fmt.Errorf("unable to determine vault ID %s", id)
+	}
+	name := decodeSlash(idParts[0])
+	switch {
+	case idParts[1] == "shared" && len(idParts) == 2:
+		v := true
+		scope.Shared = &v
+	case idParts[1] == "service" && len(idParts) == 3:
+		v := decodeSlash(idParts[2])
+		scope.Service = &v
+	case idParts[1] == "user" && len(idParts) == 3:
+		v := decodeSlash(idParts[2])
+		scope.Username = &v
+	case idParts[1] == "user":
+	default:
+		return "", scope, fmt.Errorf("unable to determine vault ID %s, the scope must be one of user, service or shared", id)
+	}
+	return name, scope, nil
+}
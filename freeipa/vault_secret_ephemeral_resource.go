@@ -0,0 +1,182 @@
+// Authors:
+//	Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &VaultSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &VaultSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigValidators = &VaultSecretEphemeralResource{}
+
+func NewVaultSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &VaultSecretEphemeralResource{}
+}
+
+// VaultSecretEphemeralResource defines the ephemeral resource implementation.
+type VaultSecretEphemeralResource struct {
+	client *ipa.Client
+}
+
+// VaultSecretEphemeralResourceModel describes the ephemeral resource data model.
+type VaultSecretEphemeralResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Username   types.String `tfsdk:"username"`
+	Service    types.String `tfsdk:"service"`
+	Shared     types.Bool   `tfsdk:"shared"`
+	Password   types.String `tfsdk:"password"`
+	PrivateKey types.String `tfsdk:"private_key"`
+	Data       types.String `tfsdk:"data"`
+}
+
+func (r *VaultSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_secret"
+}
+
+func (r *VaultSecretEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.Conflicting(
+			path.MatchRoot("username"),
+			path.MatchRoot("service"),
+			path.MatchRoot("shared"),
+		),
+	}
+}
+
+func (r *VaultSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA vault secret ephemeral resource.\nRetrieves and decrypts the payload of a vault, the payload is never stored in the state nor in the plan.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Vault name",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Login of the user owning the vault",
+				Optional:            true,
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Principal of the service owning the vault",
+				Optional:            true,
+			},
+			"shared": schema.BoolAttribute{
+				MarkdownDescription: "The vault is in the shared container",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of a `symmetric` vault",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded RSA private key of an `asymmetric` vault",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "Payload of the vault",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *VaultSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VaultSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data VaultSecretEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := vaultScopeFromModel(data.Username, data.Service, data.Shared)
+	all := true
+	optArgs := ipa.VaultShowOptionalArgs{
+		All:      &all,
+		Username: scope.Username,
+		Service:  scope.Service,
+		Shared:   scope.Shared,
+	}
+	res, err := r.client.VaultShow(&ipa.VaultShowArgs{Cn: data.Name.ValueString()}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa vault: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa vault %s", res.Result.String()))
+
+	vaultType := "standard"
+	if res.Result.Ipavaulttype != nil {
+		vaultType = *res.Result.Ipavaulttype
+	}
+	if vaultType == "symmetric" && data.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing vault password", "The password is required to retrieve the payload of a symmetric vault.")
+		return
+	}
+	if vaultType == "asymmetric" && data.PrivateKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("private_key"), "Missing vault private key", "The private key is required to retrieve the payload of an asymmetric vault.")
+		return
+	}
+	var salt []byte
+	if res.Result.Ipavaultsalt != nil {
+		salt, err = base64.StdEncoding.DecodeString(*res.Result.Ipavaultsalt)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error decoding freeipa vault salt: %s", err))
+			return
+		}
+	}
+
+	payload, err := vaultRetrieve(r.client, data.Name.ValueString(), scope)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	secret, err := vaultDecrypt(vaultType, payload, data.Password.ValueString(), salt, []byte(data.PrivateKey.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error decrypting freeipa vault data: %s", err))
+		return
+	}
+	data.Data = types.StringValue(string(secret))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,183 @@
+// Authors:
+//   Antoine Gatineau <antoine.gatineau@infra-monkey.com>
+//
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAVault_shared(t *testing.T) {
+	testVault := map[string]string{
+		"index":   "0",
+		"name":    "\"testacc-vault-shared\"",
+		"shared":  "true",
+		"data_wo": "\"Secret123\"",
+	}
+	testVaultModified := map[string]string{
+		"index":           "0",
+		"name":            "\"testacc-vault-shared\"",
+		"shared":          "true",
+		"description":     "\"Shared vault for acceptance tests\"",
+		"data_wo":         "\"Secret456\"",
+		"data_wo_version": "2",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAVault_resource(testVault),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_vault.vault-0", "id", "testacc-vault-shared/shared"),
+					resource.TestCheckResourceAttr("freeipa_vault.vault-0", "type", "standard"),
+					resource.TestCheckNoResourceAttr("freeipa_vault.vault-0", "data_wo"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAVault_resource(testVaultModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_vault.vault-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_vault.vault-0", "description", "Shared vault for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_vault.vault-0", "data_wo_version", "2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAVault_resource(testVaultModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAVault_symmetric(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testVaultMissingPassword := map[string]string{
+		"index":    "0",
+		"name":     "\"testacc-vault-symmetric\"",
+		"type":     "\"symmetric\"",
+		"username": "freeipa_user.user-0.name",
+	}
+	testVault := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-vault-symmetric\"",
+		"type":        "\"symmetric\"",
+		"username":    "freeipa_user.user-0.name",
+		"password_wo": "\"VaultPassword123\"",
+		"data_wo":     "\"Secret123\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAVault_resource(testVaultMissingPassword),
+				ExpectError: regexp.MustCompile("Missing vault password"),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAVault_resource(testVault),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_vault.vault-0", "id", "testacc-vault-symmetric/user/testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_vault.vault-0", "type", "symmetric"),
+					resource.TestCheckNoResourceAttr("freeipa_vault.vault-0", "password_wo"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAVault_resource(testVault),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAVaultMembership(t *testing.T) {
+	testVault := map[string]string{
+		"index":  "0",
+		"name":   "\"testacc-vault-membership\"",
+		"shared": "true",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group-0\"",
+	}
+	testMembership := map[string]string{
+		"index":        "0",
+		"name":         "freeipa_vault.vault-0.name",
+		"shared":       "true",
+		"identifier":   "\"testacc\"",
+		"owner_users":  "[freeipa_user.user-0.name]",
+		"member_users": "[freeipa_user.user-0.name]",
+	}
+	testMembershipModified := map[string]string{
+		"index":         "0",
+		"name":          "freeipa_vault.vault-0.name",
+		"shared":        "true",
+		"identifier":    "\"testacc\"",
+		"owner_groups":  "[freeipa_group.group-0.name]",
+		"member_users":  "[freeipa_user.user-0.name]",
+		"member_groups": "[freeipa_group.group-0.name]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAVault_resource(testVault) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAVaultMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_vault_membership.vault-membership-0", "id", "testacc-vault-membership/shared/vm/testacc"),
+					resource.TestCheckResourceAttr("freeipa_vault_membership.vault-membership-0", "owner_users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_vault_membership.vault-membership-0", "owner_users.0", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_vault_membership.vault-membership-0", "member_users.#", "1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAVault_resource(testVault) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAVaultMembership_resource(testMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_vault_membership.vault-membership-0", "owner_users"),
+					resource.TestCheckResourceAttr("freeipa_vault_membership.vault-membership-0", "owner_groups.0", "testacc-group-0"),
+					resource.TestCheckResourceAttr("freeipa_vault_membership.vault-membership-0", "member_groups.0", "testacc-group-0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAVault_resource(testVault) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAVaultMembership_resource(testMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}